@@ -0,0 +1,150 @@
+// Package veritas provides arrecadação (utility and tax slip) barcode validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// arrecadacaoSegments maps segment identifiers to their FEBRABAN description.
+var arrecadacaoSegments = map[byte]string{
+	'1': "Prefeituras",
+	'2': "Saneamento",
+	'3': "Energia elétrica e gás",
+	'4': "Telecomunicações",
+	'5': "Órgãos governamentais",
+	'6': "Carnês e assemelhados",
+	'7': "Multas de trânsito",
+	'9': "Uso exclusivo do banco",
+}
+
+// Arrecadacao is a parsed arrecadação (convênio) slip.
+type Arrecadacao struct {
+	Barcode       string
+	DigitableLine string
+	Segment       string
+	SegmentName   string
+	ValueType     string
+	// IsRealValue reports whether the amount is in reais (value types 6
+	// and 8) rather than a reference quantity (value types 7 and 9).
+	IsRealValue bool
+	AmountCents int64
+	// CompanyID holds the 4-digit company or agency code, or the first
+	// 8 CNPJ digits for segment 6.
+	CompanyID string
+	FreeField string
+}
+
+// ValidateArrecadacao validates an arrecadação slip given as a 48-digit
+// linha digitável or a 44-digit barcode.
+func ValidateArrecadacao(arrecadacao interface{}) error {
+	_, err := ParseArrecadacao(arrecadacao)
+	return err
+}
+
+// ParseArrecadacao validates an arrecadação slip and decodes its fields. Both
+// the linha digitável and the barcode are returned, so it also converts between them.
+func ParseArrecadacao(arrecadacao interface{}) (Arrecadacao, error) {
+	arrecadacaoStr, ok := arrecadacao.(string)
+	if !ok {
+		return Arrecadacao{}, fmt.Errorf("arrecadação must be a string")
+	}
+
+	// Clean the arrecadação string (remove non-numeric characters)
+	arrecadacaoStr = onlyDigits(arrecadacaoStr)
+
+	if len(arrecadacaoStr) != 44 && len(arrecadacaoStr) != 48 {
+		return Arrecadacao{}, fmt.Errorf("arrecadação must have 48 (linha digitável) or 44 (barcode) digits")
+	}
+	if arrecadacaoStr[0] != '8' {
+		return Arrecadacao{}, fmt.Errorf("arrecadação must start with 8")
+	}
+	if _, ok := arrecadacaoSegments[arrecadacaoStr[1]]; !ok {
+		return Arrecadacao{}, fmt.Errorf("invalid arrecadação segment")
+	}
+
+	checkDigit, err := arrecadacaoCheckDigitFunc(arrecadacaoStr[2])
+	if err != nil {
+		return Arrecadacao{}, err
+	}
+
+	barcode, err := arrecadacaoBarcode(arrecadacaoStr, checkDigit)
+	if err != nil {
+		return Arrecadacao{}, err
+	}
+
+	// Compare with the general check digit
+	if barcode[3] != checkDigit(barcode[:3]+barcode[4:]) {
+		return Arrecadacao{}, fmt.Errorf("invalid arrecadação general check digit")
+	}
+
+	return decodeArrecadacao(barcode, checkDigit), nil
+}
+
+// arrecadacaoCheckDigitFunc returns the check digit function selected by the
+// value type: 6 and 7 use modulo 10, 8 and 9 use modulo 11.
+func arrecadacaoCheckDigitFunc(valueType byte) (func(string) byte, error) {
+	switch valueType {
+	case '6', '7':
+		return mod10CheckDigit, nil
+	case '8', '9':
+		return arrecadacaoMod11CheckDigit, nil
+	default:
+		return nil, fmt.Errorf("invalid arrecadação value type")
+	}
+}
+
+// arrecadacaoBarcode returns the barcode of a cleaned slip, converting and
+// checking the block check digits of a linha digitável.
+func arrecadacaoBarcode(arrecadacao string, checkDigit func(string) byte) (string, error) {
+	if len(arrecadacao) == 44 {
+		return arrecadacao, nil
+	}
+
+	barcode := ""
+	for i := 0; i < 48; i += 12 {
+		block := arrecadacao[i : i+11]
+		if arrecadacao[i+11] != checkDigit(block) {
+			return "", fmt.Errorf("invalid arrecadação block check digit")
+		}
+		barcode += block
+	}
+	return barcode, nil
+}
+
+// decodeArrecadacao extracts the fields of a valid barcode.
+func decodeArrecadacao(barcode string, checkDigit func(string) byte) Arrecadacao {
+	line := ""
+	for i := 0; i < 44; i += 11 {
+		line += barcode[i:i+11] + string(checkDigit(barcode[i:i+11]))
+	}
+
+	// Segment 6 identifies the company by the first 8 digits of its CNPJ
+	companyEnd := 19
+	if barcode[1] == '6' {
+		companyEnd = 23
+	}
+
+	amount, _ := strconv.ParseInt(barcode[4:15], 10, 64)
+	return Arrecadacao{
+		Barcode:       barcode,
+		DigitableLine: line,
+		Segment:       barcode[1:2],
+		SegmentName:   arrecadacaoSegments[barcode[1]],
+		ValueType:     barcode[2:3],
+		IsRealValue:   barcode[2] == '6' || barcode[2] == '8',
+		AmountCents:   amount,
+		CompanyID:     barcode[15:companyEnd],
+		FreeField:     barcode[companyEnd:],
+	}
+}
+
+// arrecadacaoMod11CheckDigit calculates the modulo 11 check digit used by
+// arrecadação slips. Remainders 0 and 1 yield 0.
+func arrecadacaoMod11CheckDigit(digits string) byte {
+	remainder := mod11RightToLeft(digits)
+	if remainder < 2 {
+		return '0'
+	}
+	return byte('0' + 11 - remainder)
+}
@@ -0,0 +1,154 @@
+// Package veritas provides comprehensive unit tests for arrecadação validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseArrecadacao_ValidCases tests decoding of valid arrecadação slips
+func TestParseArrecadacao_ValidCases(t *testing.T) {
+	tests := []struct {
+		name            string
+		arrecadacao     string
+		expectedBarcode string
+		expectedLine    string
+		expectedSegment string
+		expectedReal    bool
+		expectedAmount  int64
+		expectedCompany string
+	}{
+		{
+			name:            "Energy slip linha digitável with modulo 10",
+			arrecadacao:     "83620000001-3 15020138000-6 00000000000-0 00000000000-0",
+			expectedBarcode: "83620000001150201380000000000000000000000000",
+			expectedLine:    "836200000013150201380006000000000000000000000000",
+			expectedSegment: "3",
+			expectedReal:    true,
+			expectedAmount:  11502,
+			expectedCompany: "0138",
+		},
+		{
+			name:            "Energy slip barcode with modulo 10",
+			arrecadacao:     "83620000001150201380000000000000000000000000",
+			expectedBarcode: "83620000001150201380000000000000000000000000",
+			expectedLine:    "836200000013150201380006000000000000000000000000",
+			expectedSegment: "3",
+			expectedReal:    true,
+			expectedAmount:  11502,
+			expectedCompany: "0138",
+		},
+		{
+			name:            "Government slip with modulo 11",
+			arrecadacao:     "858000000003500010600002012345678900123456789010",
+			expectedBarcode: "85800000000500010600000123456789012345678901",
+			expectedLine:    "858000000003500010600002012345678900123456789010",
+			expectedSegment: "5",
+			expectedReal:    true,
+			expectedAmount:  5000,
+			expectedCompany: "1060",
+		},
+		{
+			name:            "Segment 6 slip identified by CNPJ",
+			arrecadacao:     "866700000015000012345674800000000003000000000018",
+			expectedBarcode: "86670000001000012345678000000000000000000001",
+			expectedLine:    "866700000015000012345674800000000003000000000018",
+			expectedSegment: "6",
+			expectedReal:    true,
+			expectedAmount:  10000,
+			expectedCompany: "12345678",
+		},
+		{
+			name:            "Telecom slip with reference value and modulo 11",
+			arrecadacao:     "84920000000000000000000000000000000000000001",
+			expectedBarcode: "84920000000000000000000000000000000000000001",
+			expectedLine:    "849200000008000000000000000000000000000000000019",
+			expectedSegment: "4",
+			expectedReal:    false,
+			expectedAmount:  0,
+			expectedCompany: "0000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arrecadacao, err := ParseArrecadacao(tt.arrecadacao)
+			if err != nil {
+				t.Fatalf("ParseArrecadacao() unexpected error: %v", err)
+			}
+			if arrecadacao.Barcode != tt.expectedBarcode {
+				t.Errorf("ParseArrecadacao() Barcode = %v, expected %v", arrecadacao.Barcode, tt.expectedBarcode)
+			}
+			if arrecadacao.DigitableLine != tt.expectedLine {
+				t.Errorf("ParseArrecadacao() DigitableLine = %v, expected %v", arrecadacao.DigitableLine, tt.expectedLine)
+			}
+			if arrecadacao.Segment != tt.expectedSegment {
+				t.Errorf("ParseArrecadacao() Segment = %v, expected %v", arrecadacao.Segment, tt.expectedSegment)
+			}
+			if arrecadacao.IsRealValue != tt.expectedReal {
+				t.Errorf("ParseArrecadacao() IsRealValue = %v, expected %v", arrecadacao.IsRealValue, tt.expectedReal)
+			}
+			if arrecadacao.AmountCents != tt.expectedAmount {
+				t.Errorf("ParseArrecadacao() AmountCents = %v, expected %v", arrecadacao.AmountCents, tt.expectedAmount)
+			}
+			if arrecadacao.CompanyID != tt.expectedCompany {
+				t.Errorf("ParseArrecadacao() CompanyID = %v, expected %v", arrecadacao.CompanyID, tt.expectedCompany)
+			}
+		})
+	}
+}
+
+// TestValidateArrecadacao_InvalidCases tests invalid arrecadação slips
+func TestValidateArrecadacao_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name        string
+		arrecadacao interface{}
+		expected    string
+	}{
+		{
+			name:        "Integer input",
+			arrecadacao: 836200000011,
+			expected:    "arrecadação must be a string",
+		},
+		{
+			name:        "Wrong length",
+			arrecadacao: "8362000000115020138000000000000000000000000",
+			expected:    "arrecadação must have 48 (linha digitável) or 44 (barcode) digits",
+		},
+		{
+			name:        "Bank boleto barcode",
+			arrecadacao: "34191846000000020001790001043510049102015000",
+			expected:    "arrecadação must start with 8",
+		},
+		{
+			name:        "Invalid segment",
+			arrecadacao: "80620000001150201380000000000000000000000000",
+			expected:    "invalid arrecadação segment",
+		},
+		{
+			name:        "Invalid value type",
+			arrecadacao: "83520000001150201380000000000000000000000000",
+			expected:    "invalid arrecadação value type",
+		},
+		{
+			name:        "Invalid block check digit",
+			arrecadacao: "836200000013150201380007000000000000000000000000",
+			expected:    "invalid arrecadação block check digit",
+		},
+		{
+			name:        "Invalid general check digit",
+			arrecadacao: "83630000001150201380000000000000000000000000",
+			expected:    "invalid arrecadação general check digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateArrecadacao(tt.arrecadacao)
+			if err == nil {
+				t.Errorf("ValidateArrecadacao() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateArrecadacao() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package veritas provides Brazilian bank code validation functions.
+package veritas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrBankNotFound is returned when a well-formed COMPE code or ISPB is
+// missing from a bank directory.
+var ErrBankNotFound = errors.New("bank not found")
+
+//go:generate go run ./internal/gentables banks
+
+// defaultBanks is the directory built from the embedded participant list of
+// the Brazilian payment system.
+var defaultBanks = mustLoadEmbeddedTable("tables/banks.json", decodeBankDirectory)
+
+// Bank is an institution of the Brazilian payment system, identified by its
+// COMPE code and its ISPB (the first eight digits of its CNPJ).
+type Bank struct {
+	Code string `json:"code"`
+	ISPB string `json:"ispb"`
+	Name string `json:"name"`
+}
+
+// BankDirectory looks up banks by COMPE code or ISPB.
+type BankDirectory struct {
+	byCode map[string]Bank
+	byISPB map[string]Bank
+}
+
+// NewBankDirectory creates a BankDirectory holding the given banks. Banks
+// without a COMPE code can only be found by ISPB.
+func NewBankDirectory(banks []Bank) *BankDirectory {
+	directory := &BankDirectory{
+		byCode: make(map[string]Bank, len(banks)),
+		byISPB: make(map[string]Bank, len(banks)),
+	}
+	for _, bank := range banks {
+		bank.Code = onlyDigits(bank.Code)
+		bank.ISPB = onlyDigits(bank.ISPB)
+		if bank.Code != "" {
+			directory.byCode[bank.Code] = bank
+		}
+		if bank.ISPB != "" {
+			directory.byISPB[bank.ISPB] = bank
+		}
+	}
+	return directory
+}
+
+// LoadBankDirectoryFile creates a BankDirectory from a JSON file holding an
+// array of {"code", "ispb", "name"} objects, the format of the embedded list,
+// to validate against a newer participant list than the embedded one.
+func LoadBankDirectoryFile(path string) (*BankDirectory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading bank directory file: %w", err)
+	}
+	return decodeBankDirectory(data)
+}
+
+// ValidateBankCode validates a 3-digit COMPE code or 8-digit ISPB against the
+// embedded participant list.
+func ValidateBankCode(code interface{}) error {
+	_, err := ParseBankCode(code)
+	return err
+}
+
+// ParseBankCode validates a COMPE code or ISPB and returns the bank from the
+// embedded participant list.
+func ParseBankCode(code interface{}) (Bank, error) {
+	return defaultBanks.Lookup(code)
+}
+
+// ValidateBankCode validates a COMPE code or ISPB and checks that the
+// directory lists it.
+func (d *BankDirectory) ValidateBankCode(code interface{}) error {
+	_, err := d.Lookup(code)
+	return err
+}
+
+// Lookup validates a COMPE code or ISPB and returns the bank it identifies.
+func (d *BankDirectory) Lookup(code interface{}) (Bank, error) {
+	codeStr, err := cleanBankCode(code)
+	if err != nil {
+		return Bank{}, err
+	}
+
+	byCode := d.byCode
+	if len(codeStr) == 8 {
+		byCode = d.byISPB
+	}
+	bank, ok := byCode[codeStr]
+	if !ok {
+		return Bank{}, ErrBankNotFound
+	}
+	return bank, nil
+}
+
+// cleanBankCode strips formatting from a bank code and checks its length.
+func cleanBankCode(code interface{}) (string, error) {
+	codeStr, ok := code.(string)
+	if !ok {
+		return "", fmt.Errorf("bank code must be a string")
+	}
+
+	// Clean the bank code string (remove non-numeric characters)
+	codeStr = onlyDigits(codeStr)
+
+	if len(codeStr) != 3 && len(codeStr) != 8 {
+		return "", fmt.Errorf("bank code must have 3 (COMPE) or 8 (ISPB) digits")
+	}
+	return codeStr, nil
+}
+
+// decodeBankDirectory parses the JSON representation of a bank directory.
+func decodeBankDirectory(data []byte) (*BankDirectory, error) {
+	var banks []Bank
+	if err := json.Unmarshal(data, &banks); err != nil {
+		return nil, fmt.Errorf("decoding bank directory file: %w", err)
+	}
+	return NewBankDirectory(banks), nil
+}
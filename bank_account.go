@@ -0,0 +1,208 @@
+// Package veritas provides Brazilian bank account validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// bankAccountRule describes the agency and account layout of a bank with a
+// published check digit algorithm.
+type bankAccountRule struct {
+	// agencyDigit calculates the agency check digit; nil when agencies have none.
+	agencyDigit func(agency string) byte
+	// accountLength is the number of account digits before the check digit.
+	accountLength int
+	// accountDigit calculates the account check digit.
+	accountDigit func(agency, account string) byte
+	// validate applies extra checks to the padded account, such as operation codes.
+	validate func(account string) error
+}
+
+// bankAccountRules maps COMPE codes to their account rules. Banks missing
+// from this table only have their formats validated.
+var bankAccountRules = map[string]bankAccountRule{
+	// Banco do Brasil: modulo 11 with weights 5-2 (agency) and 9-2 (account), 10 is X
+	"001": {
+		agencyDigit:   func(agency string) byte { return bankMod11Digit(agency, []int{5, 4, 3, 2}, 'X') },
+		accountLength: 8,
+		accountDigit: func(_, account string) byte {
+			return bankMod11Digit(account, []int{9, 8, 7, 6, 5, 4, 3, 2}, 'X')
+		},
+	},
+	// Santander: agency, "00" and account weighted 9,7,3,1,0,0,9,7,1,3,1,9,7,3 keeping
+	// only the units of each product
+	"033": {
+		accountLength: 8,
+		accountDigit: func(agency, account string) byte {
+			weights := []int{9, 7, 3, 1, 0, 0, 9, 7, 1, 3, 1, 9, 7, 3}
+			sum := 0
+			for i, digit := range agency + "00" + account {
+				sum += int(digit-'0') * weights[i] % 10
+			}
+			return byte('0' + (10-sum%10)%10)
+		},
+	},
+	// Banrisul: modulo 11 with weights 3,2,4,7,6,5,4,3,2; remainder 0 is 0 and 1 is 6
+	"041": {
+		accountLength: 9,
+		accountDigit: func(_, account string) byte {
+			switch remainder := weightedSum(account, []int{3, 2, 4, 7, 6, 5, 4, 3, 2}) % 11; remainder {
+			case 0:
+				return '0'
+			case 1:
+				return '6'
+			default:
+				return byte('0' + 11 - remainder)
+			}
+		},
+	},
+	// Caixa: operation code and account, modulo 11 over agency and account
+	"104": {
+		accountLength: 11,
+		accountDigit: func(agency, account string) byte {
+			sum := weightedSum(agency+account, []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})
+			return byte('0' + sum*10%11%10)
+		},
+		validate: func(account string) error {
+			if _, ok := caixaOperations[account[:3]]; !ok {
+				return fmt.Errorf("invalid Caixa operation code: %s", account[:3])
+			}
+			return nil
+		},
+	},
+	// Bradesco: modulo 11 with weights 5-2 (agency) and 2,7-2 (account), 10 is P
+	"237": {
+		agencyDigit:   func(agency string) byte { return bankMod11Digit(agency, []int{5, 4, 3, 2}, 'P') },
+		accountLength: 7,
+		accountDigit: func(_, account string) byte {
+			return bankMod11Digit(account, []int{2, 7, 6, 5, 4, 3, 2}, 'P')
+		},
+	},
+	// Itaú: modulo 10 over agency and account
+	"341": {
+		accountLength: 5,
+		accountDigit: func(agency, account string) byte {
+			return mod10CheckDigit(agency + account)
+		},
+	},
+}
+
+// caixaOperations maps the Caixa operation codes that prefix account numbers
+// to the kind of account.
+var caixaOperations = map[string]string{
+	"001": "Conta corrente pessoa física",
+	"002": "Conta simples pessoa física",
+	"003": "Conta corrente pessoa jurídica",
+	"006": "Entidades públicas",
+	"007": "Depósitos de instituições financeiras",
+	"013": "Poupança pessoa física",
+	"022": "Poupança pessoa jurídica",
+	"023": "Conta Caixa Fácil",
+	"028": "Poupança de crédito imobiliário",
+	"043": "Depósitos lotéricos",
+}
+
+var (
+	bankAgencyFormat  = regexp.MustCompile(`^[0-9]{4}[0-9A-Z]?$`)
+	bankAccountFormat = regexp.MustCompile(`^[0-9]{1,19}[0-9A-Z]$`)
+)
+
+// ValidateBankAccount validates an agency and account of the given bank,
+// identified by its COMPE code or ISPB. The account must include its check
+// digit and the agency may include its own. Check digits are verified for
+// Banco do Brasil, Santander, Banrisul, Caixa (operation code followed by the
+// account number), Bradesco and Itaú; other banks of the embedded participant
+// list, such as Sicredi, only have their formats validated.
+func ValidateBankAccount(bankCode, agency, account interface{}) error {
+	return defaultBanks.ValidateBankAccount(bankCode, agency, account)
+}
+
+// ValidateBankAccount validates an agency and account of a bank listed in
+// the directory.
+func (d *BankDirectory) ValidateBankAccount(bankCode, agency, account interface{}) error {
+	bank, err := d.Lookup(bankCode)
+	if err != nil {
+		return err
+	}
+	return validateBankAccount(bank, agency, account)
+}
+
+// validateBankAccount validates an agency and account of bank, whose COMPE
+// code selects the check digit rules.
+func validateBankAccount(bank Bank, agency, account interface{}) error {
+	agencyStr, ok := agency.(string)
+	if !ok {
+		return fmt.Errorf("agency must be a string")
+	}
+	accountStr, ok := account.(string)
+	if !ok {
+		return fmt.Errorf("account must be a string")
+	}
+
+	// Clean the agency and account strings (remove formatting characters)
+	agencyStr = normalizeBankNumber(agencyStr)
+	accountStr = normalizeBankNumber(accountStr)
+
+	if !bankAgencyFormat.MatchString(agencyStr) {
+		return fmt.Errorf("agency must have 4 digits and an optional check digit")
+	}
+	if !bankAccountFormat.MatchString(accountStr) {
+		return fmt.Errorf("account must have digits followed by a check digit")
+	}
+
+	rule, ok := bankAccountRules[bank.Code]
+	if !ok {
+		return nil
+	}
+
+	// Compare with the agency check digit when one was given
+	agencyBase := agencyStr[:4]
+	if len(agencyStr) == 5 {
+		if rule.agencyDigit == nil {
+			return fmt.Errorf("agencies of %s have no check digit", bank.Name)
+		}
+		if agencyStr[4] != rule.agencyDigit(agencyBase) {
+			return fmt.Errorf("invalid agency check digit")
+		}
+	}
+
+	accountBase, checkDigit := accountStr[:len(accountStr)-1], accountStr[len(accountStr)-1]
+	if len(accountBase) > rule.accountLength {
+		return fmt.Errorf("account must have at most %d digits plus check digit", rule.accountLength)
+	}
+	accountBase = strings.Repeat("0", rule.accountLength-len(accountBase)) + accountBase
+
+	if rule.validate != nil {
+		if err := rule.validate(accountBase); err != nil {
+			return err
+		}
+	}
+
+	// Compare with the account check digit
+	if checkDigit != rule.accountDigit(agencyBase, accountBase) {
+		return fmt.Errorf("invalid account check digit")
+	}
+
+	return nil
+}
+
+// normalizeBankNumber strips formatting from an agency or account and
+// uppercases letter check digits such as x and p.
+func normalizeBankNumber(number string) string {
+	return strings.ToUpper(regexp.MustCompile(`[^0-9A-Za-z]`).ReplaceAllString(number, ""))
+}
+
+// bankMod11Digit calculates a modulo 11 check digit as 11 minus the
+// remainder, where 10 becomes tenDigit and 11 becomes 0.
+func bankMod11Digit(number string, weights []int, tenDigit byte) byte {
+	switch checkDigit := 11 - weightedSum(number, weights)%11; checkDigit {
+	case 10:
+		return tenDigit
+	case 11:
+		return '0'
+	default:
+		return byte('0' + checkDigit)
+	}
+}
@@ -0,0 +1,171 @@
+// Package veritas provides comprehensive unit tests for Brazilian bank account validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestValidateBankAccount_ValidCases tests valid agencies and accounts of each bank rule
+func TestValidateBankAccount_ValidCases(t *testing.T) {
+	tests := []struct {
+		name    string
+		bank    string
+		agency  string
+		account string
+	}{
+		{name: "Banco do Brasil", bank: "001", agency: "1584-9", account: "00210169-6"},
+		{name: "Banco do Brasil without agency digit", bank: "001", agency: "1584", account: "210169-6"},
+		{name: "Banco do Brasil with X digits", bank: "001", agency: "0006-x", account: "00000006-X"},
+		{name: "Banco do Brasil by ISPB", bank: "00000000", agency: "1584-9", account: "00210169-6"},
+		{name: "Santander", bank: "033", agency: "0189", account: "01017417-9"},
+		{name: "Banrisul", bank: "041", agency: "0001", account: "35.850767.1-8"},
+		{name: "Caixa", bank: "104", agency: "2004", account: "001.00000448-6"},
+		{name: "Bradesco", bank: "237", agency: "1425-7", account: "0238069-2"},
+		{name: "Bradesco with P digit", bank: "237", agency: "1425-7", account: "0000006-P"},
+		{name: "Itaú", bank: "341", agency: "2545", account: "02366-1"},
+		{name: "Sicredi format only", bank: "748", agency: "0101", account: "12345-6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateBankAccount(tt.bank, tt.agency, tt.account); err != nil {
+				t.Errorf("ValidateBankAccount() error = %v, expected nil", err)
+			}
+		})
+	}
+}
+
+// TestValidateBankAccount_InvalidCases tests invalid agencies and accounts
+func TestValidateBankAccount_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		bank     interface{}
+		agency   interface{}
+		account  interface{}
+		expected string
+	}{
+		{
+			name:     "Invalid bank code",
+			bank:     "99",
+			agency:   "1584",
+			account:  "00210169-6",
+			expected: "bank code must have 3 (COMPE) or 8 (ISPB) digits",
+		},
+		{
+			name:     "Unknown bank",
+			bank:     "999",
+			agency:   "1584",
+			account:  "00210169-6",
+			expected: "bank not found",
+		},
+		{
+			name:     "Integer agency",
+			bank:     "001",
+			agency:   1584,
+			account:  "00210169-6",
+			expected: "agency must be a string",
+		},
+		{
+			name:     "Integer account",
+			bank:     "001",
+			agency:   "1584",
+			account:  210169,
+			expected: "account must be a string",
+		},
+		{
+			name:     "Short agency",
+			bank:     "001",
+			agency:   "158",
+			account:  "00210169-6",
+			expected: "agency must have 4 digits and an optional check digit",
+		},
+		{
+			name:     "Account without check digit",
+			bank:     "748",
+			agency:   "0101",
+			account:  "-",
+			expected: "account must have digits followed by a check digit",
+		},
+		{
+			name:     "Banco do Brasil wrong agency digit",
+			bank:     "001",
+			agency:   "1584-8",
+			account:  "00210169-6",
+			expected: "invalid agency check digit",
+		},
+		{
+			name:     "Banco do Brasil wrong account digit",
+			bank:     "001",
+			agency:   "1584-9",
+			account:  "00210169-7",
+			expected: "invalid account check digit",
+		},
+		{
+			name:     "Banco do Brasil account too long",
+			bank:     "001",
+			agency:   "1584-9",
+			account:  "100210169-6",
+			expected: "account must have at most 8 digits plus check digit",
+		},
+		{
+			name:     "Itaú agency with check digit",
+			bank:     "341",
+			agency:   "2545-1",
+			account:  "02366-1",
+			expected: "agencies of Itaú Unibanco have no check digit",
+		},
+		{
+			name:     "Itaú account of another agency",
+			bank:     "341",
+			agency:   "2546",
+			account:  "02366-1",
+			expected: "invalid account check digit",
+		},
+		{
+			name:     "Santander wrong account digit",
+			bank:     "033",
+			agency:   "0189",
+			account:  "01017417-0",
+			expected: "invalid account check digit",
+		},
+		{
+			name:     "Caixa unknown operation",
+			bank:     "104",
+			agency:   "2004",
+			account:  "999.00000448-6",
+			expected: "invalid Caixa operation code: 999",
+		},
+		{
+			name:     "Caixa wrong account digit",
+			bank:     "104",
+			agency:   "2004",
+			account:  "001.00000448-5",
+			expected: "invalid account check digit",
+		},
+		{
+			name:     "Bradesco wrong account digit",
+			bank:     "237",
+			agency:   "1425-7",
+			account:  "0238069-P",
+			expected: "invalid account check digit",
+		},
+		{
+			name:     "Banrisul wrong account digit",
+			bank:     "041",
+			agency:   "0001",
+			account:  "35.850767.1-9",
+			expected: "invalid account check digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBankAccount(tt.bank, tt.agency, tt.account)
+			if err == nil {
+				t.Errorf("ValidateBankAccount() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateBankAccount() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Package veritas provides comprehensive unit tests for Brazilian bank code validation functions.
+package veritas
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseBankCode_ValidCases tests lookups by COMPE code and ISPB
+func TestParseBankCode_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected Bank
+	}{
+		{
+			name:     "COMPE code",
+			code:     "341",
+			expected: Bank{Code: "341", ISPB: "60701190", Name: "Itaú Unibanco"},
+		},
+		{
+			name:     "COMPE code with leading zeros",
+			code:     "001",
+			expected: Bank{Code: "001", ISPB: "00000000", Name: "Banco do Brasil"},
+		},
+		{
+			name:     "ISPB",
+			code:     "18236120",
+			expected: Bank{Code: "260", ISPB: "18236120", Name: "Nu Pagamentos"},
+		},
+		{
+			name:     "Formatted ISPB",
+			code:     "00.360.305",
+			expected: Bank{Code: "104", ISPB: "00360305", Name: "Caixa Econômica Federal"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank, err := ParseBankCode(tt.code)
+			if err != nil {
+				t.Fatalf("ParseBankCode() unexpected error: %v", err)
+			}
+			// Names follow the participant list, so only the codes are compared
+			if bank.Code != tt.expected.Code || bank.ISPB != tt.expected.ISPB || bank.Name == "" {
+				t.Errorf("ParseBankCode() = %+v, expected %+v", bank, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateBankCode_InvalidCases tests invalid bank codes
+func TestValidateBankCode_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			code:     341,
+			expected: "bank code must be a string",
+		},
+		{
+			name:     "Two digits",
+			code:     "41",
+			expected: "bank code must have 3 (COMPE) or 8 (ISPB) digits",
+		},
+		{
+			name:     "Four digits",
+			code:     "0001",
+			expected: "bank code must have 3 (COMPE) or 8 (ISPB) digits",
+		},
+		{
+			name:     "Unknown COMPE code",
+			code:     "999",
+			expected: "bank not found",
+		},
+		{
+			name:     "Unknown ISPB",
+			code:     "99999999",
+			expected: "bank not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBankCode(tt.code)
+			if err == nil {
+				t.Errorf("ValidateBankCode() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateBankCode() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestBanks_Consistency tests that the embedded directory is keyed by each
+// bank's code and ISPB
+func TestBanks_Consistency(t *testing.T) {
+	if len(defaultBanks.byISPB) == 0 {
+		t.Fatal("embedded directory is empty")
+	}
+	for code, bank := range defaultBanks.byCode {
+		if bank.Code != code || len(bank.Code) != 3 {
+			t.Errorf("byCode[%s].Code = %v", code, bank.Code)
+		}
+		if defaultBanks.byISPB[bank.ISPB] != bank {
+			t.Errorf("byISPB[%s] = %+v, expected %+v", bank.ISPB, defaultBanks.byISPB[bank.ISPB], bank)
+		}
+	}
+	for ispb, bank := range defaultBanks.byISPB {
+		if bank.ISPB != ispb || len(bank.ISPB) != 8 {
+			t.Errorf("byISPB[%s].ISPB = %v, expected 8 digits", ispb, bank.ISPB)
+		}
+	}
+}
+
+// TestLoadBankDirectoryFile tests lookups in a directory loaded from a file
+func TestLoadBankDirectoryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banks.json")
+	data := `[
+		{"code": "121", "ispb": "10664513", "name": "Agibank"},
+		{"code": "", "ispb": "00038166", "name": "BCB - Selic"}
+	]`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	directory, err := LoadBankDirectoryFile(path)
+	if err != nil {
+		t.Fatalf("LoadBankDirectoryFile() unexpected error: %v", err)
+	}
+
+	if bank, err := directory.Lookup("121"); err != nil || bank.Name != "Agibank" {
+		t.Errorf("Lookup() = %+v, %v, expected Agibank", bank, err)
+	}
+	if bank, err := directory.Lookup("00038166"); err != nil || bank.Name != "BCB - Selic" {
+		t.Errorf("Lookup() = %+v, %v, expected BCB - Selic", bank, err)
+	}
+	if err := directory.ValidateBankCode("341"); !errors.Is(err, ErrBankNotFound) {
+		t.Errorf("ValidateBankCode() error = %v, expected %v", err, ErrBankNotFound)
+	}
+	if err := directory.ValidateBankCode("1"); err == nil || err.Error() != "bank code must have 3 (COMPE) or 8 (ISPB) digits" {
+		t.Errorf("ValidateBankCode() error = %v, expected a format error", err)
+	}
+	if err := directory.ValidateBankAccount("121", "0001", "123456"); err != nil {
+		t.Errorf("ValidateBankAccount() unexpected error: %v", err)
+	}
+	if err := directory.ValidateBankAccount("001", "1584-9", "00210169-6"); !errors.Is(err, ErrBankNotFound) {
+		t.Errorf("ValidateBankAccount() error = %v, expected %v", err, ErrBankNotFound)
+	}
+
+	if _, err := LoadBankDirectoryFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("LoadBankDirectoryFile() expected error for missing file, got nil")
+	}
+}
@@ -0,0 +1,89 @@
+// Package veritas provides SWIFT/BIC validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// iso3166Countries holds the ISO 3166-1 alpha-2 country codes, plus XK
+// (Kosovo), which SWIFT and the IBAN registry use.
+var iso3166Countries = codeSet(`
+	AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ
+	BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM
+	DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS
+	GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN
+	KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ
+	MR MS MT MU MV MW MX MY MZ NA NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM
+	PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV
+	SX SY SZ TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI
+	VN VU WF WS YE YT ZA ZM ZW XK`)
+
+// bicFormat matches a BIC: party prefix, country, location and optional branch.
+var bicFormat = regexp.MustCompile(`^([0-9A-Z]{4})([A-Z]{2})([0-9A-Z]{2})([0-9A-Z]{3})?$`)
+
+// codeSet builds a set from whitespace-separated codes.
+func codeSet(codes string) map[string]bool {
+	set := map[string]bool{}
+	for _, code := range strings.Fields(codes) {
+		set[code] = true
+	}
+	return set
+}
+
+// BIC is a validated SWIFT Business Identifier Code.
+type BIC struct {
+	BIC             string
+	InstitutionCode string
+	CountryCode     string
+	LocationCode    string
+	// BranchCode is "XXX" for the primary office, including 8-character BICs.
+	BranchCode string
+	// Test reports whether this is a test and training BIC (location ending in 0).
+	Test bool
+}
+
+// ValidateBIC validates a SWIFT Business Identifier Code with 8 or 11 characters.
+func ValidateBIC(bic interface{}) error {
+	_, err := ParseBIC(bic)
+	return err
+}
+
+// ParseBIC validates a BIC and returns its institution, country, location and branch codes.
+func ParseBIC(bic interface{}) (BIC, error) {
+	bicStr, ok := bic.(string)
+	if !ok {
+		return BIC{}, fmt.Errorf("BIC must be a string")
+	}
+
+	// Clean the BIC string (remove spaces and use uppercase)
+	bicStr = strings.ToUpper(strings.Join(strings.Fields(bicStr), ""))
+
+	// Check if BIC has 8 or 11 characters
+	if len(bicStr) != 8 && len(bicStr) != 11 {
+		return BIC{}, fmt.Errorf("BIC must have 8 or 11 characters")
+	}
+
+	match := bicFormat.FindStringSubmatch(bicStr)
+	if match == nil {
+		return BIC{}, fmt.Errorf("invalid BIC format")
+	}
+	if !iso3166Countries[match[2]] {
+		return BIC{}, fmt.Errorf("invalid BIC country code: %s", match[2])
+	}
+
+	branch := match[4]
+	if branch == "" {
+		branch = "XXX"
+	}
+
+	return BIC{
+		BIC:             bicStr,
+		InstitutionCode: match[1],
+		CountryCode:     match[2],
+		LocationCode:    match[3],
+		BranchCode:      branch,
+		Test:            match[3][1] == '0',
+	}, nil
+}
@@ -0,0 +1,119 @@
+// Package veritas provides comprehensive unit tests for SWIFT/BIC validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseBIC_ValidCases tests parsing of valid BICs
+func TestParseBIC_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		bic      string
+		expected BIC
+	}{
+		{
+			name: "8-character BIC",
+			bic:  "BRASBRRJ",
+			expected: BIC{
+				BIC:             "BRASBRRJ",
+				InstitutionCode: "BRAS",
+				CountryCode:     "BR",
+				LocationCode:    "RJ",
+				BranchCode:      "XXX",
+			},
+		},
+		{
+			name: "11-character BIC",
+			bic:  "DEUTDEFF500",
+			expected: BIC{
+				BIC:             "DEUTDEFF500",
+				InstitutionCode: "DEUT",
+				CountryCode:     "DE",
+				LocationCode:    "FF",
+				BranchCode:      "500",
+			},
+		},
+		{
+			name: "Lowercase with spaces",
+			bic:  "cefx br sp",
+			expected: BIC{
+				BIC:             "CEFXBRSP",
+				InstitutionCode: "CEFX",
+				CountryCode:     "BR",
+				LocationCode:    "SP",
+				BranchCode:      "XXX",
+			},
+		},
+		{
+			name: "Test BIC",
+			bic:  "DEUTDEF0XXX",
+			expected: BIC{
+				BIC:             "DEUTDEF0XXX",
+				InstitutionCode: "DEUT",
+				CountryCode:     "DE",
+				LocationCode:    "F0",
+				BranchCode:      "XXX",
+				Test:            true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bic, err := ParseBIC(tt.bic)
+			if err != nil {
+				t.Fatalf("ParseBIC() unexpected error: %v", err)
+			}
+			if bic != tt.expected {
+				t.Errorf("ParseBIC() = %+v, expected %+v", bic, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateBIC_InvalidCases tests invalid BICs
+func TestValidateBIC_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		bic      interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			bic:      12345678,
+			expected: "BIC must be a string",
+		},
+		{
+			name:     "Nine characters",
+			bic:      "DEUTDEFF5",
+			expected: "BIC must have 8 or 11 characters",
+		},
+		{
+			name:     "Digits in country code",
+			bic:      "DEUT12FF",
+			expected: "invalid BIC format",
+		},
+		{
+			name:     "Symbols",
+			bic:      "DEU-DEFF",
+			expected: "invalid BIC format",
+		},
+		{
+			name:     "Unknown country",
+			bic:      "DEUTQQFF",
+			expected: "invalid BIC country code: QQ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBIC(tt.bic)
+			if err == nil {
+				t.Errorf("ValidateBIC() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateBIC() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Package veritas provides boleto bancário validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var (
+	// boletoFactorBase is the date due date factors were counted from until
+	// factor 9999 was reached.
+	boletoFactorBase = time.Date(1997, time.October, 7, 0, 0, 0, 0, time.UTC)
+	// boletoFactorReset is the date factor 1000 was reused after 9999 was
+	// reached on 2025-02-21.
+	boletoFactorReset = time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC)
+)
+
+// Boleto is a parsed bank boleto.
+type Boleto struct {
+	Barcode       string
+	DigitableLine string
+	BankCode      string
+	CurrencyCode  string
+	DueDateFactor int
+	// DueDate is zero when the boleto has no due date (factor 0000).
+	DueDate time.Time
+	// AmountCents is the amount in cents; zero means the payer informs it.
+	AmountCents int64
+	FreeField   string
+}
+
+// ValidateBoleto validates a bank boleto given as a 47-digit linha digitável
+// or a 44-digit barcode.
+func ValidateBoleto(boleto interface{}) error {
+	_, err := ParseBoleto(boleto)
+	return err
+}
+
+// ParseBoleto validates a bank boleto and decodes its fields. Both the
+// linha digitável and the barcode are returned, so it also converts between them.
+func ParseBoleto(boleto interface{}) (Boleto, error) {
+	boletoStr, ok := boleto.(string)
+	if !ok {
+		return Boleto{}, fmt.Errorf("boleto must be a string")
+	}
+
+	// Clean the boleto string (remove non-numeric characters)
+	boletoStr = onlyDigits(boletoStr)
+
+	barcode, err := boletoBarcode(boletoStr)
+	if err != nil {
+		return Boleto{}, err
+	}
+
+	// Compare with the general check digit
+	if barcode[4] != boletoGeneralCheckDigit(barcode) {
+		return Boleto{}, fmt.Errorf("invalid boleto general check digit")
+	}
+
+	return decodeBoleto(barcode, time.Now()), nil
+}
+
+// boletoBarcode returns the barcode of a cleaned boleto, converting and
+// checking the field check digits of a linha digitável.
+func boletoBarcode(boleto string) (string, error) {
+	if len(boleto) > 0 && boleto[0] == '8' {
+		return "", fmt.Errorf("boleto starting with 8 is an arrecadação slip")
+	}
+
+	switch len(boleto) {
+	case 44:
+		return boleto, nil
+	case 47:
+		fields := []string{boleto[0:10], boleto[10:21], boleto[21:32]}
+		for _, field := range fields {
+			if field[len(field)-1] != mod10CheckDigit(field[:len(field)-1]) {
+				return "", fmt.Errorf("invalid boleto field check digit")
+			}
+		}
+		return boleto[0:4] + boleto[32:47] + boleto[4:9] + boleto[10:20] + boleto[21:31], nil
+	default:
+		return "", fmt.Errorf("boleto must have 47 (linha digitável) or 44 (barcode) digits")
+	}
+}
+
+// boletoDigitableLine converts a barcode to its linha digitável.
+func boletoDigitableLine(barcode string) string {
+	fields := []string{barcode[0:4] + barcode[19:24], barcode[24:34], barcode[34:44]}
+	line := ""
+	for _, field := range fields {
+		line += field + string(mod10CheckDigit(field))
+	}
+	return line + barcode[4:5] + barcode[5:19]
+}
+
+// decodeBoleto extracts the fields of a valid barcode. The due date factor
+// is resolved against ref, since factors restarted at 1000 in February 2025.
+func decodeBoleto(barcode string, ref time.Time) Boleto {
+	factor, _ := strconv.Atoi(barcode[5:9])
+	amount, _ := strconv.ParseInt(barcode[9:19], 10, 64)
+	return Boleto{
+		Barcode:       barcode,
+		DigitableLine: boletoDigitableLine(barcode),
+		BankCode:      barcode[0:3],
+		CurrencyCode:  barcode[3:4],
+		DueDateFactor: factor,
+		DueDate:       boletoDueDate(factor, ref),
+		AmountCents:   amount,
+		FreeField:     barcode[19:44],
+	}
+}
+
+// boletoDueDate converts a due date factor to a date, choosing between the
+// original and the restarted factor cycles whichever is closest to ref.
+func boletoDueDate(factor int, ref time.Time) time.Time {
+	if factor == 0 {
+		return time.Time{}
+	}
+
+	original := boletoFactorBase.AddDate(0, 0, factor)
+	restarted := boletoFactorReset.AddDate(0, 0, factor-1000)
+	if factor < 1000 || ref.Sub(original).Abs() <= ref.Sub(restarted).Abs() {
+		return original
+	}
+	return restarted
+}
+
+// boletoGeneralCheckDigit calculates the modulo 11 general check digit of a
+// barcode, skipping the check digit position.
+func boletoGeneralCheckDigit(barcode string) byte {
+	checkDigit := 11 - mod11RightToLeft(barcode[:4]+barcode[5:])
+	if checkDigit == 0 || checkDigit >= 10 {
+		checkDigit = 1
+	}
+	return byte('0' + checkDigit)
+}
+
+// mod10CheckDigit calculates a FEBRABAN modulo 10 check digit. Digits are
+// weighted 2, 1, 2... from the right and two-digit products are summed digit by digit.
+func mod10CheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * (2 - (len(digits)-1-i)%2)
+		sum += product/10 + product%10
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// mod11RightToLeft returns the remainder by 11 of the digits weighted 2 to 9
+// from the right, restarting at 2 after 9.
+func mod11RightToLeft(digits string) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * (2 + (len(digits)-1-i)%8)
+	}
+	return sum % 11
+}
@@ -0,0 +1,191 @@
+// Package veritas provides comprehensive unit tests for boleto validation functions.
+package veritas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseBoleto_ValidCases tests decoding of valid boletos in both representations
+func TestParseBoleto_ValidCases(t *testing.T) {
+	tests := []struct {
+		name            string
+		boleto          string
+		expectedBarcode string
+		expectedLine    string
+		expectedBank    string
+		expectedFactor  int
+		expectedAmount  int64
+	}{
+		{
+			name:            "Formatted linha digitável",
+			boleto:          "34191.79001 01043.510047 91020.150008 1 84600000002000",
+			expectedBarcode: "34191846000000020001790001043510049102015000",
+			expectedLine:    "34191790010104351004791020150008184600000002000",
+			expectedBank:    "341",
+			expectedFactor:  8460,
+			expectedAmount:  2000,
+		},
+		{
+			name:            "Barcode",
+			boleto:          "34191846000000020001790001043510049102015000",
+			expectedBarcode: "34191846000000020001790001043510049102015000",
+			expectedLine:    "34191790010104351004791020150008184600000002000",
+			expectedBank:    "341",
+			expectedFactor:  8460,
+			expectedAmount:  2000,
+		},
+		{
+			name:            "Linha digitável with restarted factor",
+			boleto:          "00190000090123456789701234567897610000000012345",
+			expectedBarcode: "00196100000000123450000001234567890123456789",
+			expectedLine:    "00190000090123456789701234567897610000000012345",
+			expectedBank:    "001",
+			expectedFactor:  1000,
+			expectedAmount:  12345,
+		},
+		{
+			name:            "Barcode without due date or amount",
+			boleto:          "23795000000000000001234512345678901234567890",
+			expectedBarcode: "23795000000000000001234512345678901234567890",
+			expectedLine:    "23791234541234567890312345678903500000000000000",
+			expectedBank:    "237",
+			expectedFactor:  0,
+			expectedAmount:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			boleto, err := ParseBoleto(tt.boleto)
+			if err != nil {
+				t.Fatalf("ParseBoleto() unexpected error: %v", err)
+			}
+			if boleto.Barcode != tt.expectedBarcode {
+				t.Errorf("ParseBoleto() Barcode = %v, expected %v", boleto.Barcode, tt.expectedBarcode)
+			}
+			if boleto.DigitableLine != tt.expectedLine {
+				t.Errorf("ParseBoleto() DigitableLine = %v, expected %v", boleto.DigitableLine, tt.expectedLine)
+			}
+			if boleto.BankCode != tt.expectedBank {
+				t.Errorf("ParseBoleto() BankCode = %v, expected %v", boleto.BankCode, tt.expectedBank)
+			}
+			if boleto.DueDateFactor != tt.expectedFactor {
+				t.Errorf("ParseBoleto() DueDateFactor = %v, expected %v", boleto.DueDateFactor, tt.expectedFactor)
+			}
+			if boleto.AmountCents != tt.expectedAmount {
+				t.Errorf("ParseBoleto() AmountCents = %v, expected %v", boleto.AmountCents, tt.expectedAmount)
+			}
+		})
+	}
+}
+
+// TestValidateBoleto_InvalidCases tests invalid boletos
+func TestValidateBoleto_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		boleto   interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			boleto:   12345,
+			expected: "boleto must be a string",
+		},
+		{
+			name:     "Wrong length",
+			boleto:   "3419179001010435100479102015000818460000000200",
+			expected: "boleto must have 47 (linha digitável) or 44 (barcode) digits",
+		},
+		{
+			name:     "Arrecadação slip",
+			boleto:   "83640000001150201380000000000000000000000000",
+			expected: "boleto starting with 8 is an arrecadação slip",
+		},
+		{
+			name:     "Invalid first field check digit",
+			boleto:   "34191.79002 01043.510047 91020.150008 1 84600000002000",
+			expected: "invalid boleto field check digit",
+		},
+		{
+			name:     "Invalid third field check digit",
+			boleto:   "34191.79001 01043.510047 91020.150009 1 84600000002000",
+			expected: "invalid boleto field check digit",
+		},
+		{
+			name:     "Invalid general check digit in linha digitável",
+			boleto:   "34191.79001 01043.510047 91020.150008 2 84600000002000",
+			expected: "invalid boleto general check digit",
+		},
+		{
+			name:     "Altered amount in barcode",
+			boleto:   "34191846000000020011790001043510049102015000",
+			expected: "invalid boleto general check digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBoleto(tt.boleto)
+			if err == nil {
+				t.Errorf("ValidateBoleto() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateBoleto() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestBoletoDueDate tests due date factor decoding across the February 2025 reset
+func TestBoletoDueDate(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		factor   int
+		ref      time.Time
+		expected time.Time
+	}{
+		{
+			name:     "Factor 1000 in the original cycle",
+			factor:   1000,
+			ref:      date(2000, time.June, 1),
+			expected: date(2000, time.July, 3),
+		},
+		{
+			name:     "Last factor of the original cycle",
+			factor:   9999,
+			ref:      date(2025, time.February, 1),
+			expected: date(2025, time.February, 21),
+		},
+		{
+			name:     "Factor 1000 after the reset",
+			factor:   1000,
+			ref:      date(2025, time.March, 1),
+			expected: date(2025, time.February, 22),
+		},
+		{
+			name:     "Overdue boleto from the original cycle read after the reset",
+			factor:   9900,
+			ref:      date(2025, time.March, 1),
+			expected: date(2024, time.November, 14),
+		},
+		{
+			name:     "No due date",
+			factor:   0,
+			ref:      date(2025, time.March, 1),
+			expected: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := boletoDueDate(tt.factor, tt.ref)
+			if !result.Equal(tt.expected) {
+				t.Errorf("boletoDueDate() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
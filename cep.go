@@ -0,0 +1,127 @@
+// Package veritas provides Brazilian CEP (postal code) validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// cepRange is a numeric CEP range assigned to a UF.
+type cepRange struct {
+	uf    string
+	first int
+	last  int
+}
+
+// cepRanges lists the CEP ranges assigned to each UF by Correios.
+var cepRanges = []cepRange{
+	{"SP", 1000000, 19999999},
+	{"RJ", 20000000, 28999999},
+	{"ES", 29000000, 29999999},
+	{"MG", 30000000, 39999999},
+	{"BA", 40000000, 48999999},
+	{"SE", 49000000, 49999999},
+	{"PE", 50000000, 56999999},
+	{"AL", 57000000, 57999999},
+	{"PB", 58000000, 58999999},
+	{"RN", 59000000, 59999999},
+	{"CE", 60000000, 63999999},
+	{"PI", 64000000, 64999999},
+	{"MA", 65000000, 65999999},
+	{"PA", 66000000, 68899999},
+	{"AP", 68900000, 68999999},
+	{"AM", 69000000, 69299999},
+	{"RR", 69300000, 69399999},
+	{"AM", 69400000, 69899999},
+	{"AC", 69900000, 69999999},
+	{"DF", 70000000, 72799999},
+	{"GO", 72800000, 72999999},
+	{"DF", 73000000, 73699999},
+	{"GO", 73700000, 76799999},
+	{"RO", 76800000, 76999999},
+	{"TO", 77000000, 77999999},
+	{"MT", 78000000, 78899999},
+	{"MS", 79000000, 79999999},
+	{"PR", 80000000, 87999999},
+	{"SC", 88000000, 89999999},
+	{"RS", 90000000, 99999999},
+}
+
+// cepFormat matches the 00000-000 and 00000000 CEP forms.
+var cepFormat = regexp.MustCompile(`^\d{5}-?\d{3}$`)
+
+// CEP is a parsed Brazilian postal code.
+type CEP struct {
+	Number    string
+	Region    string
+	SubRegion string
+	UF        string
+}
+
+// ValidateCEP validates a Brazilian CEP. When ufs are given, the CEP must fall
+// within the range assigned to one of them.
+func ValidateCEP(cep interface{}, ufs ...string) error {
+	parsed, err := ParseCEP(cep)
+	if err != nil {
+		return err
+	}
+
+	if len(ufs) == 0 {
+		return nil
+	}
+	for _, uf := range ufs {
+		if strings.EqualFold(strings.TrimSpace(uf), parsed.UF) {
+			return nil
+		}
+	}
+	return fmt.Errorf("CEP does not belong to %s", strings.ToUpper(strings.Join(ufs, ", ")))
+}
+
+// ParseCEP validates a CEP and returns its region, sub-region and the UF
+// derived from its numeric range.
+func ParseCEP(cep interface{}) (CEP, error) {
+	cepStr, ok := cep.(string)
+	if !ok {
+		return CEP{}, fmt.Errorf("CEP must be a string")
+	}
+
+	cepStr = strings.TrimSpace(cepStr)
+	if !cepFormat.MatchString(cepStr) {
+		return CEP{}, fmt.Errorf("CEP must be in the format 00000-000 or 00000000")
+	}
+
+	cepStr = strings.Replace(cepStr, "-", "", 1)
+	uf := cepUF(cepStr)
+	if uf == "" {
+		return CEP{}, fmt.Errorf("CEP is not assigned to any UF")
+	}
+
+	return CEP{
+		Number:    cepStr,
+		Region:    cepStr[:1],
+		SubRegion: cepStr[:2],
+		UF:        uf,
+	}, nil
+}
+
+// FormatCEP validates a CEP and formats it as 00000-000.
+func FormatCEP(cep interface{}) (string, error) {
+	parsed, err := ParseCEP(cep)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Number[:5] + "-" + parsed.Number[5:], nil
+}
+
+// cepUF returns the UF whose range contains an 8-digit CEP, or an empty string.
+func cepUF(cep string) string {
+	number, _ := strconv.Atoi(cep)
+	for _, r := range cepRanges {
+		if number >= r.first && number <= r.last {
+			return r.uf
+		}
+	}
+	return ""
+}
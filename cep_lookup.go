@@ -0,0 +1,304 @@
+// Package veritas provides CEP address lookup clients.
+package veritas
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// VIACEP_BASE_URL is the base URL of the public ViaCEP API.
+	VIACEP_BASE_URL = "https://viacep.com.br/ws"
+	// CEP_CACHE_SIZE is the number of addresses kept by the default
+	// HTTPCEPLookup cache.
+	CEP_CACHE_SIZE = 10000
+	// CEP_CACHE_TTL is how long the default HTTPCEPLookup cache keeps an
+	// address.
+	CEP_CACHE_TTL = 24 * time.Hour
+)
+
+// ErrCEPNotFound is returned when a valid CEP has no known address.
+var ErrCEPNotFound = errors.New("CEP not found")
+
+// Address is the address a CEP resolves to.
+type Address struct {
+	CEP          string
+	Street       string
+	Complement   string
+	Neighborhood string
+	City         string
+	UF           string
+}
+
+// CEPLookup resolves a CEP to its address.
+type CEPLookup interface {
+	Lookup(ctx context.Context, cep string) (Address, error)
+}
+
+// viaCEPAddress is the JSON representation used by ViaCEP-style APIs.
+type viaCEPAddress struct {
+	CEP          string      `json:"cep"`
+	Street       string      `json:"logradouro"`
+	Complement   string      `json:"complemento"`
+	Neighborhood string      `json:"bairro"`
+	City         string      `json:"localidade"`
+	UF           string      `json:"uf"`
+	Error        interface{} `json:"erro"`
+}
+
+// address converts the JSON representation to an Address.
+func (v viaCEPAddress) address() Address {
+	return Address{
+		CEP:          strings.Replace(v.CEP, "-", "", 1),
+		Street:       v.Street,
+		Complement:   v.Complement,
+		Neighborhood: v.Neighborhood,
+		City:         v.City,
+		UF:           v.UF,
+	}
+}
+
+// notFound reports whether the API flagged the CEP as unknown.
+func (v viaCEPAddress) notFound() bool {
+	return v.Error == true || v.Error == "true"
+}
+
+// CEPCache stores the addresses resolved by an HTTPCEPLookup. It must be safe
+// for concurrent use.
+type CEPCache interface {
+	Get(cep string) (Address, bool)
+	Add(cep string, address Address)
+}
+
+// MemoryCEPCache is a CEPCache keeping a limited number of addresses for a
+// limited time, evicting the least recently used address when full.
+type MemoryCEPCache struct {
+	size    int
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// memoryCEPCacheEntry is an address kept by a MemoryCEPCache.
+type memoryCEPCacheEntry struct {
+	cep     string
+	address Address
+	expires time.Time
+}
+
+// NewMemoryCEPCache creates a MemoryCEPCache keeping up to size addresses for
+// ttl each. A ttl of zero keeps addresses until they are evicted.
+func NewMemoryCEPCache(size int, ttl time.Duration) *MemoryCEPCache {
+	return &MemoryCEPCache{
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the address cached for a CEP, unless it has expired.
+func (c *MemoryCEPCache) Get(cep string) (Address, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[cep]
+	if !ok {
+		return Address{}, false
+	}
+	entry := element.Value.(*memoryCEPCacheEntry)
+	if c.ttl > 0 && !c.now().Before(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, cep)
+		return Address{}, false
+	}
+	c.order.MoveToFront(element)
+	return entry.address, true
+}
+
+// Add caches the address of a CEP, evicting the least recently used address
+// when the cache is full.
+func (c *MemoryCEPCache) Add(cep string, address Address) {
+	if c.size <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &memoryCEPCacheEntry{cep: cep, address: address, expires: c.now().Add(c.ttl)}
+	if element, ok := c.entries[cep]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCEPCacheEntry).cep)
+	}
+	c.entries[cep] = c.order.PushFront(entry)
+}
+
+// Len returns the number of cached addresses, including expired ones not
+// evicted yet.
+func (c *MemoryCEPCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// HTTPCEPLookup resolves CEPs through a ViaCEP-compatible JSON API and caches
+// successful results.
+type HTTPCEPLookup struct {
+	baseURL string
+	client  *http.Client
+	cache   CEPCache
+}
+
+// NewHTTPCEPLookup creates an HTTPCEPLookup for the given base URL. An empty
+// base URL uses ViaCEP, a nil client uses a client with a 10-second timeout
+// and a nil cache uses a MemoryCEPCache of CEP_CACHE_SIZE addresses kept for
+// CEP_CACHE_TTL.
+func NewHTTPCEPLookup(baseURL string, client *http.Client, cache CEPCache) *HTTPCEPLookup {
+	if baseURL == "" {
+		baseURL = VIACEP_BASE_URL
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	if cache == nil {
+		cache = NewMemoryCEPCache(CEP_CACHE_SIZE, CEP_CACHE_TTL)
+	}
+	return &HTTPCEPLookup{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  client,
+		cache:   cache,
+	}
+}
+
+// Lookup resolves a CEP to its address.
+func (l *HTTPCEPLookup) Lookup(ctx context.Context, cep string) (Address, error) {
+	parsed, err := ParseCEP(cep)
+	if err != nil {
+		return Address{}, err
+	}
+
+	if address, ok := l.cache.Get(parsed.Number); ok {
+		return address, nil
+	}
+
+	address, err := l.fetch(ctx, parsed.Number)
+	if err != nil {
+		return Address{}, err
+	}
+
+	l.cache.Add(parsed.Number, address)
+	return address, nil
+}
+
+// fetch queries the API for an 8-digit CEP.
+func (l *HTTPCEPLookup) fetch(ctx context.Context, cep string) (Address, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, l.baseURL+"/"+cep+"/json/", nil)
+	if err != nil {
+		return Address{}, fmt.Errorf("creating CEP lookup request: %w", err)
+	}
+
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return Address{}, fmt.Errorf("CEP lookup failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Address{}, fmt.Errorf("CEP lookup returned status %d, expected 200", resp.StatusCode)
+	}
+
+	var result viaCEPAddress
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return Address{}, fmt.Errorf("decoding CEP lookup response: %w", err)
+	}
+	if result.notFound() {
+		return Address{}, ErrCEPNotFound
+	}
+	return result.address(), nil
+}
+
+// MemoryCEPLookup resolves CEPs from a fixed set of addresses. It is meant as
+// a local stand-in for HTTPCEPLookup in tests and offline environments.
+type MemoryCEPLookup struct {
+	addresses map[string]Address
+}
+
+// NewMemoryCEPLookup creates a MemoryCEPLookup holding the given addresses.
+func NewMemoryCEPLookup(addresses []Address) *MemoryCEPLookup {
+	byCEP := make(map[string]Address, len(addresses))
+	for _, address := range addresses {
+		address.CEP = onlyDigits(address.CEP)
+		byCEP[address.CEP] = address
+	}
+	return &MemoryCEPLookup{addresses: byCEP}
+}
+
+// LoadCEPLookupFile creates a MemoryCEPLookup from a JSON file holding an
+// array of ViaCEP-style address objects.
+func LoadCEPLookupFile(path string) (*MemoryCEPLookup, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading CEP file: %w", err)
+	}
+
+	var entries []viaCEPAddress
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("decoding CEP file: %w", err)
+	}
+
+	addresses := make([]Address, 0, len(entries))
+	for _, entry := range entries {
+		addresses = append(addresses, entry.address())
+	}
+	return NewMemoryCEPLookup(addresses), nil
+}
+
+// Lookup resolves a CEP to its address.
+func (l *MemoryCEPLookup) Lookup(ctx context.Context, cep string) (Address, error) {
+	parsed, err := ParseCEP(cep)
+	if err != nil {
+		return Address{}, err
+	}
+
+	address, ok := l.addresses[parsed.Number]
+	if !ok {
+		return Address{}, ErrCEPNotFound
+	}
+	return address, nil
+}
+
+// ValidateAddress resolves the address CEP through lookup and checks that the
+// given city and UF match it. City names are compared ignoring case and accents;
+// empty fields are not checked.
+func ValidateAddress(ctx context.Context, lookup CEPLookup, address Address) error {
+	resolved, err := lookup.Lookup(ctx, address.CEP)
+	if err != nil {
+		return err
+	}
+
+	if address.City != "" && foldName(address.City) != foldName(resolved.City) {
+		return fmt.Errorf("city %s does not match CEP city %s", address.City, resolved.City)
+	}
+	if address.UF != "" && !strings.EqualFold(strings.TrimSpace(address.UF), resolved.UF) {
+		return fmt.Errorf("UF %s does not match CEP UF %s", address.UF, resolved.UF)
+	}
+	return nil
+}
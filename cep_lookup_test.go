@@ -0,0 +1,252 @@
+// Package veritas provides comprehensive unit tests for CEP lookup clients.
+package veritas
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newViaCEPServer starts a test server that answers like ViaCEP and counts requests
+func newViaCEPServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		switch r.URL.Path {
+		case "/ws/80010000/json/":
+			w.Write([]byte(`{"cep":"80010-000","logradouro":"Praça Tiradentes","bairro":"Centro","localidade":"Curitiba","uf":"PR"}`))
+		case "/ws/99999999/json/":
+			w.Write([]byte(`{"erro":"true"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestHTTPCEPLookup_Lookup tests resolving CEPs through a ViaCEP-style API
+func TestHTTPCEPLookup_Lookup(t *testing.T) {
+	requests := 0
+	server := newViaCEPServer(t, &requests)
+	lookup := NewHTTPCEPLookup(server.URL+"/ws/", server.Client(), nil)
+
+	address, err := lookup.Lookup(context.Background(), "80010-000")
+	if err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if address.CEP != "80010000" || address.City != "Curitiba" || address.UF != "PR" {
+		t.Errorf("Lookup() = %+v, expected Curitiba/PR", address)
+	}
+	if address.Street != "Praça Tiradentes" || address.Neighborhood != "Centro" {
+		t.Errorf("Lookup() = %+v, expected Praça Tiradentes/Centro", address)
+	}
+
+	if _, err := lookup.Lookup(context.Background(), "80010000"); err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Lookup() made %d requests, expected 1 due to caching", requests)
+	}
+}
+
+// TestHTTPCEPLookup_Errors tests lookup failures
+func TestHTTPCEPLookup_Errors(t *testing.T) {
+	requests := 0
+	server := newViaCEPServer(t, &requests)
+	lookup := NewHTTPCEPLookup(server.URL+"/ws", server.Client(), nil)
+
+	if _, err := lookup.Lookup(context.Background(), "99999-999"); !errors.Is(err, ErrCEPNotFound) {
+		t.Errorf("Lookup() error = %v, expected %v", err, ErrCEPNotFound)
+	}
+
+	if _, err := lookup.Lookup(context.Background(), "12345-678"); err == nil {
+		t.Errorf("Lookup() expected error for non-200 status, got nil")
+	}
+
+	if _, err := lookup.Lookup(context.Background(), "1234"); err == nil {
+		t.Errorf("Lookup() expected error for invalid CEP, got nil")
+	}
+	if requests != 2 {
+		t.Errorf("Lookup() made %d requests, expected 2", requests)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := lookup.Lookup(ctx, "80010-000"); err == nil {
+		t.Errorf("Lookup() expected error for canceled context, got nil")
+	}
+}
+
+// TestHTTPCEPLookup_InjectedCache tests resolving CEPs through a caller-provided cache
+func TestHTTPCEPLookup_InjectedCache(t *testing.T) {
+	requests := 0
+	server := newViaCEPServer(t, &requests)
+	cache := NewMemoryCEPCache(1, 0)
+	lookup := NewHTTPCEPLookup(server.URL+"/ws", server.Client(), cache)
+
+	if _, err := lookup.Lookup(context.Background(), "80010-000"); err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if address, ok := cache.Get("80010000"); !ok || address.City != "Curitiba" {
+		t.Errorf("Get() = %+v, %v, expected the looked up address", address, ok)
+	}
+
+	cache.Add("01310100", Address{CEP: "01310100", City: "São Paulo", UF: "SP"})
+	address, err := lookup.Lookup(context.Background(), "01310-100")
+	if err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if address.City != "São Paulo" || requests != 1 {
+		t.Errorf("Lookup() = %+v after %d requests, expected the cached address after 1", address, requests)
+	}
+}
+
+// TestMemoryCEPCache_Size tests evicting the least recently used address
+func TestMemoryCEPCache_Size(t *testing.T) {
+	cache := NewMemoryCEPCache(2, 0)
+	cache.Add("80010000", Address{City: "Curitiba"})
+	cache.Add("01310100", Address{City: "São Paulo"})
+	cache.Get("80010000")
+	cache.Add("20040020", Address{City: "Rio de Janeiro"})
+
+	if cache.Len() != 2 {
+		t.Errorf("Len() = %d, expected 2", cache.Len())
+	}
+	if _, ok := cache.Get("01310100"); ok {
+		t.Errorf("Get() found the least recently used address, expected it evicted")
+	}
+	for _, cep := range []string{"80010000", "20040020"} {
+		if _, ok := cache.Get(cep); !ok {
+			t.Errorf("Get(%q) found nothing, expected a cached address", cep)
+		}
+	}
+
+	empty := NewMemoryCEPCache(0, 0)
+	empty.Add("80010000", Address{City: "Curitiba"})
+	if empty.Len() != 0 {
+		t.Errorf("Len() = %d, expected 0 for a cache of size 0", empty.Len())
+	}
+}
+
+// TestMemoryCEPCache_TTL tests expiring cached addresses
+func TestMemoryCEPCache_TTL(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache := NewMemoryCEPCache(10, time.Hour)
+	cache.now = func() time.Time { return now }
+
+	cache.Add("80010000", Address{City: "Curitiba"})
+	now = now.Add(59 * time.Minute)
+	if _, ok := cache.Get("80010000"); !ok {
+		t.Errorf("Get() found nothing before the TTL, expected a cached address")
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("80010000"); ok {
+		t.Errorf("Get() found an address after the TTL, expected it expired")
+	}
+	if cache.Len() != 0 {
+		t.Errorf("Len() = %d, expected 0 after expiry", cache.Len())
+	}
+}
+
+// TestMemoryCEPLookup_Lookup tests the in-memory lookup
+func TestMemoryCEPLookup_Lookup(t *testing.T) {
+	lookup := NewMemoryCEPLookup([]Address{
+		{CEP: "01310-100", Street: "Avenida Paulista", City: "São Paulo", UF: "SP"},
+	})
+
+	address, err := lookup.Lookup(context.Background(), "01310100")
+	if err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if address.City != "São Paulo" {
+		t.Errorf("Lookup() City = %v, expected %v", address.City, "São Paulo")
+	}
+
+	if _, err := lookup.Lookup(context.Background(), "80010-000"); !errors.Is(err, ErrCEPNotFound) {
+		t.Errorf("Lookup() error = %v, expected %v", err, ErrCEPNotFound)
+	}
+}
+
+// TestLoadCEPLookupFile tests loading addresses from a JSON file
+func TestLoadCEPLookupFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ceps.json")
+	data := `[{"cep":"80010-000","logradouro":"Praça Tiradentes","bairro":"Centro","localidade":"Curitiba","uf":"PR"}]`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatalf("writing test file: %v", err)
+	}
+
+	lookup, err := LoadCEPLookupFile(path)
+	if err != nil {
+		t.Fatalf("LoadCEPLookupFile() unexpected error: %v", err)
+	}
+	address, err := lookup.Lookup(context.Background(), "80010-000")
+	if err != nil {
+		t.Fatalf("Lookup() unexpected error: %v", err)
+	}
+	if address.City != "Curitiba" {
+		t.Errorf("Lookup() City = %v, expected %v", address.City, "Curitiba")
+	}
+
+	if _, err := LoadCEPLookupFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("LoadCEPLookupFile() expected error, got nil")
+	}
+}
+
+// TestValidateAddress tests matching addresses against their CEP
+func TestValidateAddress(t *testing.T) {
+	lookup := NewMemoryCEPLookup([]Address{
+		{CEP: "01310100", City: "São Paulo", UF: "SP"},
+	})
+
+	tests := []struct {
+		name     string
+		address  Address
+		expected string
+	}{
+		{
+			name:    "Matching city and UF",
+			address: Address{CEP: "01310-100", City: "São Paulo", UF: "SP"},
+		},
+		{
+			name:    "City without accents and lowercase UF",
+			address: Address{CEP: "01310-100", City: "sao  paulo", UF: "sp"},
+		},
+		{
+			name:     "Different city",
+			address:  Address{CEP: "01310-100", City: "Campinas"},
+			expected: "city Campinas does not match CEP city São Paulo",
+		},
+		{
+			name:     "Different UF",
+			address:  Address{CEP: "01310-100", UF: "RJ"},
+			expected: "UF RJ does not match CEP UF SP",
+		},
+		{
+			name:     "Unknown CEP",
+			address:  Address{CEP: "80010-000", City: "Curitiba"},
+			expected: "CEP not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateAddress(context.Background(), lookup, tt.address)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateAddress() error = %v, expected nil", err)
+				}
+			} else if err == nil {
+				t.Errorf("ValidateAddress() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateAddress() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+// Package veritas provides comprehensive unit tests for CEP validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseCEP_ValidCases tests valid CEPs and their derived UF
+func TestParseCEP_ValidCases(t *testing.T) {
+	tests := []struct {
+		name              string
+		cep               string
+		expectedNumber    string
+		expectedRegion    string
+		expectedSubRegion string
+		expectedUF        string
+	}{
+		{
+			name:              "Formatted CEP from Curitiba",
+			cep:               "80010-000",
+			expectedNumber:    "80010000",
+			expectedRegion:    "8",
+			expectedSubRegion: "80",
+			expectedUF:        "PR",
+		},
+		{
+			name:              "Unformatted CEP from São Paulo",
+			cep:               "01310100",
+			expectedNumber:    "01310100",
+			expectedRegion:    "0",
+			expectedSubRegion: "01",
+			expectedUF:        "SP",
+		},
+		{
+			name:              "CEP from Roraima inside the Amazonas block",
+			cep:               "69301-000",
+			expectedNumber:    "69301000",
+			expectedRegion:    "6",
+			expectedSubRegion: "69",
+			expectedUF:        "RR",
+		},
+		{
+			name:              "CEP from the second Amazonas range",
+			cep:               "69400-000",
+			expectedNumber:    "69400000",
+			expectedRegion:    "6",
+			expectedSubRegion: "69",
+			expectedUF:        "AM",
+		},
+		{
+			name:              "CEP from the second Distrito Federal range",
+			cep:               "73000-000",
+			expectedNumber:    "73000000",
+			expectedRegion:    "7",
+			expectedSubRegion: "73",
+			expectedUF:        "DF",
+		},
+		{
+			name:              "CEP with surrounding spaces",
+			cep:               " 90010-000 ",
+			expectedNumber:    "90010000",
+			expectedRegion:    "9",
+			expectedSubRegion: "90",
+			expectedUF:        "RS",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cep, err := ParseCEP(tt.cep)
+			if err != nil {
+				t.Fatalf("ParseCEP() unexpected error: %v", err)
+			}
+			if cep.Number != tt.expectedNumber {
+				t.Errorf("ParseCEP() Number = %v, expected %v", cep.Number, tt.expectedNumber)
+			}
+			if cep.Region != tt.expectedRegion {
+				t.Errorf("ParseCEP() Region = %v, expected %v", cep.Region, tt.expectedRegion)
+			}
+			if cep.SubRegion != tt.expectedSubRegion {
+				t.Errorf("ParseCEP() SubRegion = %v, expected %v", cep.SubRegion, tt.expectedSubRegion)
+			}
+			if cep.UF != tt.expectedUF {
+				t.Errorf("ParseCEP() UF = %v, expected %v", cep.UF, tt.expectedUF)
+			}
+		})
+	}
+}
+
+// TestValidateCEP_InvalidCases tests invalid CEPs and UF mismatches
+func TestValidateCEP_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		cep      interface{}
+		ufs      []string
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			cep:      80010000,
+			expected: "CEP must be a string",
+		},
+		{
+			name:     "Too short",
+			cep:      "8001-000",
+			expected: "CEP must be in the format 00000-000 or 00000000",
+		},
+		{
+			name:     "Misplaced hyphen",
+			cep:      "800-10000",
+			expected: "CEP must be in the format 00000-000 or 00000000",
+		},
+		{
+			name:     "Letters",
+			cep:      "8001a-000",
+			expected: "CEP must be in the format 00000-000 or 00000000",
+		},
+		{
+			name:     "Unassigned range",
+			cep:      "00000-000",
+			expected: "CEP is not assigned to any UF",
+		},
+		{
+			name:     "CEP from another UF",
+			cep:      "80010-000",
+			ufs:      []string{"SC"},
+			expected: "CEP does not belong to SC",
+		},
+		{
+			name:     "CEP from none of the given UFs",
+			cep:      "01310-100",
+			ufs:      []string{"rj", "mg"},
+			expected: "CEP does not belong to RJ, MG",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCEP(tt.cep, tt.ufs...)
+			if err == nil {
+				t.Errorf("ValidateCEP() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateCEP() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateCEP_MatchingUF tests CEPs checked against their own UF
+func TestValidateCEP_MatchingUF(t *testing.T) {
+	if err := ValidateCEP("87999-999", "PR"); err != nil {
+		t.Errorf("ValidateCEP() error = %v, expected nil", err)
+	}
+	if err := ValidateCEP("88000-000", "pr", "sc"); err != nil {
+		t.Errorf("ValidateCEP() error = %v, expected nil", err)
+	}
+}
+
+// TestFormatCEP tests CEP formatting
+func TestFormatCEP(t *testing.T) {
+	result, err := FormatCEP("80010000")
+	if err != nil {
+		t.Fatalf("FormatCEP() unexpected error: %v", err)
+	}
+	if result != "80010-000" {
+		t.Errorf("FormatCEP() = %v, expected %v", result, "80010-000")
+	}
+}
@@ -0,0 +1,142 @@
+// Package veritas provides Brazilian civil registry certificate validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// CertidaoType identifies the registry book a certificate was issued from.
+type CertidaoType string
+
+const (
+	// CERTIDAO_NASCIMENTO identifies a birth record (book A).
+	CERTIDAO_NASCIMENTO CertidaoType = "NASCIMENTO"
+	// CERTIDAO_CASAMENTO identifies a civil marriage record (book B).
+	CERTIDAO_CASAMENTO CertidaoType = "CASAMENTO"
+	// CERTIDAO_CASAMENTO_RELIGIOSO identifies a religious marriage with civil effect (book B auxiliar).
+	CERTIDAO_CASAMENTO_RELIGIOSO CertidaoType = "CASAMENTO_RELIGIOSO"
+	// CERTIDAO_OBITO identifies a death record (book C).
+	CERTIDAO_OBITO CertidaoType = "OBITO"
+	// CERTIDAO_NATIMORTO identifies a stillbirth record (book C auxiliar).
+	CERTIDAO_NATIMORTO CertidaoType = "NATIMORTO"
+	// CERTIDAO_PROCLAMAS identifies a marriage banns record (book D).
+	CERTIDAO_PROCLAMAS CertidaoType = "PROCLAMAS"
+	// CERTIDAO_LIVRO_E identifies the other acts recorded in book E.
+	CERTIDAO_LIVRO_E CertidaoType = "LIVRO_E"
+)
+
+// certidaoTypes maps the book type digit of a matrícula to its certificate type.
+// Digits 8 and 9 are reserved.
+var certidaoTypes = map[byte]CertidaoType{
+	'1': CERTIDAO_NASCIMENTO,
+	'2': CERTIDAO_CASAMENTO,
+	'3': CERTIDAO_CASAMENTO_RELIGIOSO,
+	'4': CERTIDAO_OBITO,
+	'5': CERTIDAO_NATIMORTO,
+	'6': CERTIDAO_PROCLAMAS,
+	'7': CERTIDAO_LIVRO_E,
+}
+
+// CERTIDAO_SERVICE_CODE is the service code of the Registro Civil das Pessoas
+// Naturais in matrículas.
+const CERTIDAO_SERVICE_CODE = "55"
+
+// CertidaoMatricula is a parsed 32-digit matrícula of a birth, marriage or
+// death certificate.
+type CertidaoMatricula struct {
+	Matricula string
+	// CartorioCNS is the CNJ national registry (CNS) code of the registry office.
+	CartorioCNS string
+	// Archive is 01 for the office's own records and 02 for incorporated ones.
+	Archive     string
+	ServiceCode string
+	Year        int
+	Type        CertidaoType
+	Book        string
+	Page        string
+	Term        string
+	CheckDigits string
+}
+
+// ValidateCertidaoMatricula validates the matrícula of a civil registry certificate.
+func ValidateCertidaoMatricula(matricula interface{}) error {
+	_, err := ParseCertidaoMatricula(matricula)
+	return err
+}
+
+// ParseCertidaoMatricula validates the matrícula of a civil registry
+// certificate and decodes its fields.
+func ParseCertidaoMatricula(matricula interface{}) (CertidaoMatricula, error) {
+	matriculaStr, ok := matricula.(string)
+	if !ok {
+		return CertidaoMatricula{}, fmt.Errorf("matrícula must be a string")
+	}
+
+	// Clean the matrícula string (remove non-numeric characters)
+	matriculaStr = onlyDigits(matriculaStr)
+
+	// Check if matrícula has exactly 32 digits
+	if len(matriculaStr) != 32 {
+		return CertidaoMatricula{}, fmt.Errorf("matrícula must have exactly 32 digits")
+	}
+
+	// Check for invalid sequences (all same digits)
+	if isRepeatedDigits(matriculaStr) {
+		return CertidaoMatricula{}, fmt.Errorf("matrícula cannot be a sequence of identical digits")
+	}
+
+	// Compare with provided check digits
+	if matriculaStr[30:] != certidaoCheckDigits(matriculaStr[:30]) {
+		return CertidaoMatricula{}, fmt.Errorf("invalid matrícula check digits")
+	}
+
+	parsed := CertidaoMatricula{
+		Matricula:   matriculaStr,
+		CartorioCNS: matriculaStr[0:6],
+		Archive:     matriculaStr[6:8],
+		ServiceCode: matriculaStr[8:10],
+		Book:        matriculaStr[15:20],
+		Page:        matriculaStr[20:23],
+		Term:        matriculaStr[23:30],
+		CheckDigits: matriculaStr[30:],
+	}
+
+	if parsed.Archive != "01" && parsed.Archive != "02" {
+		return CertidaoMatricula{}, fmt.Errorf("invalid matrícula archive code")
+	}
+	if parsed.ServiceCode != CERTIDAO_SERVICE_CODE {
+		return CertidaoMatricula{}, fmt.Errorf("matrícula service code must be %s", CERTIDAO_SERVICE_CODE)
+	}
+
+	parsed.Year, _ = strconv.Atoi(matriculaStr[10:14])
+	if parsed.Year > time.Now().Year() {
+		return CertidaoMatricula{}, fmt.Errorf("matrícula year cannot be in the future")
+	}
+
+	if parsed.Type, ok = certidaoTypes[matriculaStr[14]]; !ok {
+		return CertidaoMatricula{}, fmt.Errorf("invalid matrícula book type")
+	}
+
+	return parsed, nil
+}
+
+// certidaoCheckDigits calculates the two modulo 11 check digits of a
+// matrícula. Weights start at 2 for the first digit and 1 for the second
+// calculation, cycling through 0 to 10; a remainder of 10 becomes 1.
+func certidaoCheckDigits(base string) string {
+	checkDigits := base
+	for start := 2; start >= 1; start-- {
+		weights := make([]int, len(checkDigits))
+		for i := range weights {
+			weights[i] = (i + start) % 11
+		}
+		remainder := weightedSum(checkDigits, weights) % 11
+		if remainder == 10 {
+			remainder = 1
+		}
+		checkDigits += strconv.Itoa(remainder)
+	}
+	return checkDigits[len(base):]
+}
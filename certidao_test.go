@@ -0,0 +1,142 @@
+// Package veritas provides comprehensive unit tests for Brazilian civil registry certificate validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseCertidaoMatricula_ValidCases tests decoding of valid matrículas
+func TestParseCertidaoMatricula_ValidCases(t *testing.T) {
+	tests := []struct {
+		name      string
+		matricula string
+		expected  CertidaoMatricula
+	}{
+		{
+			name:      "Birth certificate",
+			matricula: "10453901552012100256001000012307",
+			expected: CertidaoMatricula{
+				Matricula:   "10453901552012100256001000012307",
+				CartorioCNS: "104539",
+				Archive:     "01",
+				ServiceCode: "55",
+				Year:        2012,
+				Type:        CERTIDAO_NASCIMENTO,
+				Book:        "00256",
+				Page:        "001",
+				Term:        "0000123",
+				CheckDigits: "07",
+			},
+		},
+		{
+			name:      "Formatted death certificate",
+			matricula: "104539 01 55 2019 4 00256 001 0000123 15",
+			expected: CertidaoMatricula{
+				Matricula:   "10453901552019400256001000012315",
+				CartorioCNS: "104539",
+				Archive:     "01",
+				ServiceCode: "55",
+				Year:        2019,
+				Type:        CERTIDAO_OBITO,
+				Book:        "00256",
+				Page:        "001",
+				Term:        "0000123",
+				CheckDigits: "15",
+			},
+		},
+		{
+			name:      "Marriage certificate from incorporated archive",
+			matricula: "104539.02.55.2023.2.01234.056.0012345-45",
+			expected: CertidaoMatricula{
+				Matricula:   "10453902552023201234056001234545",
+				CartorioCNS: "104539",
+				Archive:     "02",
+				ServiceCode: "55",
+				Year:        2023,
+				Type:        CERTIDAO_CASAMENTO,
+				Book:        "01234",
+				Page:        "056",
+				Term:        "0012345",
+				CheckDigits: "45",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matricula, err := ParseCertidaoMatricula(tt.matricula)
+			if err != nil {
+				t.Fatalf("ParseCertidaoMatricula() unexpected error: %v", err)
+			}
+			if matricula != tt.expected {
+				t.Errorf("ParseCertidaoMatricula() = %+v, expected %+v", matricula, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateCertidaoMatricula_InvalidCases tests invalid matrículas
+func TestValidateCertidaoMatricula_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name      string
+		matricula interface{}
+		expected  string
+	}{
+		{
+			name:      "Integer input",
+			matricula: 104539,
+			expected:  "matrícula must be a string",
+		},
+		{
+			name:      "Too short",
+			matricula: "104539015520121002560010000123",
+			expected:  "matrícula must have exactly 32 digits",
+		},
+		{
+			name:      "All same digits",
+			matricula: "11111111111111111111111111111111",
+			expected:  "matrícula cannot be a sequence of identical digits",
+		},
+		{
+			name:      "Wrong first check digit",
+			matricula: "10453901552012100256001000012317",
+			expected:  "invalid matrícula check digits",
+		},
+		{
+			name:      "Wrong second check digit",
+			matricula: "10453901552012100256001000012308",
+			expected:  "invalid matrícula check digits",
+		},
+		{
+			name:      "Invalid archive",
+			matricula: "10453903552012100256001000012379",
+			expected:  "invalid matrícula archive code",
+		},
+		{
+			name:      "Other registry service",
+			matricula: "10453901542012100256001000012308",
+			expected:  "matrícula service code must be 55",
+		},
+		{
+			name:      "Future year",
+			matricula: "10453901552099100256001000012386",
+			expected:  "matrícula year cannot be in the future",
+		},
+		{
+			name:      "Reserved book type",
+			matricula: "10453901552012800256001000012329",
+			expected:  "invalid matrícula book type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCertidaoMatricula(tt.matricula)
+			if err == nil {
+				t.Errorf("ValidateCertidaoMatricula() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateCertidaoMatricula() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
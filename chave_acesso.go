@@ -0,0 +1,132 @@
+// Package veritas provides Brazilian fiscal document access key validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// chaveAcessoFormat matches a normalized access key. The emitter position
+// may hold an alphanumeric CNPJ; every other position is numeric.
+var chaveAcessoFormat = regexp.MustCompile(`^[0-9]{6}[0-9A-Z]{12}[0-9]{26}$`)
+
+// chaveAcessoModels maps the fiscal document models that use access keys to their names.
+var chaveAcessoModels = map[string]string{
+	"55": "NF-e",
+	"57": "CT-e",
+	"58": "MDF-e",
+	"65": "NFC-e",
+}
+
+// ChaveAcesso is a parsed access key of an NF-e, NFC-e, CT-e or MDF-e.
+type ChaveAcesso struct {
+	Key    string
+	UFCode string
+	UF     string
+	Year   int
+	Month  int
+	// Emitter is the CNPJ or CPF of the emitter. CPFs are stored in the key
+	// left-padded with "000".
+	Emitter      Document
+	Model        string
+	ModelName    string
+	Series       string
+	Number       string
+	EmissionType string
+	NumericCode  string
+	CheckDigit   string
+}
+
+// ValidateChaveAcesso validates a 44-digit access key of an NF-e, NFC-e, CT-e or MDF-e.
+func ValidateChaveAcesso(chave interface{}) error {
+	_, err := ParseChaveAcesso(chave)
+	return err
+}
+
+// ParseChaveAcesso validates an access key and decodes its fields.
+func ParseChaveAcesso(chave interface{}) (ChaveAcesso, error) {
+	chaveStr, ok := chave.(string)
+	if !ok {
+		return ChaveAcesso{}, fmt.Errorf("chave de acesso must be a string")
+	}
+
+	// Clean the chave string (remove formatting characters)
+	chaveStr = strings.ToUpper(regexp.MustCompile(`[^0-9A-Za-z]`).ReplaceAllString(chaveStr, ""))
+
+	// Check if chave has exactly 44 characters
+	if len(chaveStr) != 44 {
+		return ChaveAcesso{}, fmt.Errorf("chave de acesso must have exactly 44 digits")
+	}
+	if !chaveAcessoFormat.MatchString(chaveStr) {
+		return ChaveAcesso{}, fmt.Errorf("chave de acesso may only have letters in the emitter CNPJ")
+	}
+
+	// Compare with provided check digit
+	if chaveStr[43] != chaveAcessoCheckDigit(chaveStr[:43]) {
+		return ChaveAcesso{}, fmt.Errorf("invalid chave de acesso check digit")
+	}
+
+	parsed := ChaveAcesso{
+		Key:          chaveStr,
+		UFCode:       chaveStr[0:2],
+		Model:        chaveStr[20:22],
+		Series:       chaveStr[22:25],
+		Number:       chaveStr[25:34],
+		EmissionType: chaveStr[34:35],
+		NumericCode:  chaveStr[35:43],
+		CheckDigit:   chaveStr[43:],
+	}
+
+	uf, err := UFFromIBGECode(parsed.UFCode)
+	if err != nil {
+		return ChaveAcesso{}, fmt.Errorf("invalid chave de acesso UF code")
+	}
+	parsed.UF = uf
+
+	parsed.Year, _ = strconv.Atoi(chaveStr[2:4])
+	parsed.Year += 2000
+	parsed.Month, _ = strconv.Atoi(chaveStr[4:6])
+	if parsed.Month < 1 || parsed.Month > 12 {
+		return ChaveAcesso{}, fmt.Errorf("invalid chave de acesso emission month")
+	}
+
+	emitter, err := chaveAcessoEmitter(chaveStr[6:20])
+	if err != nil {
+		return ChaveAcesso{}, err
+	}
+	parsed.Emitter = emitter
+
+	if parsed.ModelName, ok = chaveAcessoModels[parsed.Model]; !ok {
+		return ChaveAcesso{}, fmt.Errorf("unsupported chave de acesso model: %s", parsed.Model)
+	}
+
+	if parsed.EmissionType == "0" {
+		return ChaveAcesso{}, fmt.Errorf("invalid chave de acesso emission type")
+	}
+
+	return parsed, nil
+}
+
+// chaveAcessoEmitter validates the emitter field of an access key, which
+// holds a CNPJ or a CPF left-padded with "000".
+func chaveAcessoEmitter(emitter string) (Document, error) {
+	if ValidateCNPJ(emitter) == nil {
+		return Document{Kind: DOCUMENT_CNPJ, Number: emitter}, nil
+	}
+	if strings.HasPrefix(emitter, "000") && ValidateCPF(emitter[3:]) == nil {
+		return Document{Kind: DOCUMENT_CPF, Number: emitter[3:]}, nil
+	}
+	return Document{}, fmt.Errorf("invalid chave de acesso emitter CNPJ or CPF")
+}
+
+// chaveAcessoCheckDigit calculates the modulo 11 check digit of the first 43
+// positions of an access key.
+func chaveAcessoCheckDigit(digits string) byte {
+	remainder := mod11RightToLeft(digits)
+	if remainder < 2 {
+		return '0'
+	}
+	return byte('0' + 11 - remainder)
+}
@@ -0,0 +1,189 @@
+// Package veritas provides comprehensive unit tests for fiscal document access key validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseChaveAcesso_ValidCases tests decoding of valid access keys
+func TestParseChaveAcesso_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		chave    string
+		expected ChaveAcesso
+	}{
+		{
+			name:  "NF-e emitted by CNPJ",
+			chave: "35240111222333000181550010000001231123456780",
+			expected: ChaveAcesso{
+				Key:          "35240111222333000181550010000001231123456780",
+				UFCode:       "35",
+				UF:           "SP",
+				Year:         2024,
+				Month:        1,
+				Emitter:      Document{Kind: DOCUMENT_CNPJ, Number: "11222333000181"},
+				Model:        "55",
+				ModelName:    "NF-e",
+				Series:       "001",
+				Number:       "000000123",
+				EmissionType: "1",
+				NumericCode:  "12345678",
+				CheckDigit:   "0",
+			},
+		},
+		{
+			name:  "Formatted NF-e emitted by CPF",
+			chave: "4124 0100 0111 4447 7735 5500 1000 0000 4218 7654 3217",
+			expected: ChaveAcesso{
+				Key:          "41240100011144477735550010000000421876543217",
+				UFCode:       "41",
+				UF:           "PR",
+				Year:         2024,
+				Month:        1,
+				Emitter:      Document{Kind: DOCUMENT_CPF, Number: "11144477735"},
+				Model:        "55",
+				ModelName:    "NF-e",
+				Series:       "001",
+				Number:       "000000042",
+				EmissionType: "1",
+				NumericCode:  "87654321",
+				CheckDigit:   "7",
+			},
+		},
+		{
+			name:  "CNPJ starting with 000",
+			chave: "53240100000000000191550010000000011000000016",
+			expected: ChaveAcesso{
+				Key:          "53240100000000000191550010000000011000000016",
+				UFCode:       "53",
+				UF:           "DF",
+				Year:         2024,
+				Month:        1,
+				Emitter:      Document{Kind: DOCUMENT_CNPJ, Number: "00000000000191"},
+				Model:        "55",
+				ModelName:    "NF-e",
+				Series:       "001",
+				Number:       "000000001",
+				EmissionType: "1",
+				NumericCode:  "00000001",
+				CheckDigit:   "6",
+			},
+		},
+		{
+			name:  "NFC-e emitted by alphanumeric CNPJ",
+			chave: "35240112abc34501de35650010000001231123456784",
+			expected: ChaveAcesso{
+				Key:          "35240112ABC34501DE35650010000001231123456784",
+				UFCode:       "35",
+				UF:           "SP",
+				Year:         2024,
+				Month:        1,
+				Emitter:      Document{Kind: DOCUMENT_CNPJ, Number: "12ABC34501DE35"},
+				Model:        "65",
+				ModelName:    "NFC-e",
+				Series:       "001",
+				Number:       "000000123",
+				EmissionType: "1",
+				NumericCode:  "12345678",
+				CheckDigit:   "4",
+			},
+		},
+		{
+			name:  "CT-e",
+			chave: "31240111222333000181570010000001231123456789",
+			expected: ChaveAcesso{
+				Key:          "31240111222333000181570010000001231123456789",
+				UFCode:       "31",
+				UF:           "MG",
+				Year:         2024,
+				Month:        1,
+				Emitter:      Document{Kind: DOCUMENT_CNPJ, Number: "11222333000181"},
+				Model:        "57",
+				ModelName:    "CT-e",
+				Series:       "001",
+				Number:       "000000123",
+				EmissionType: "1",
+				NumericCode:  "12345678",
+				CheckDigit:   "9",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chave, err := ParseChaveAcesso(tt.chave)
+			if err != nil {
+				t.Fatalf("ParseChaveAcesso() unexpected error: %v", err)
+			}
+			if chave != tt.expected {
+				t.Errorf("ParseChaveAcesso() = %+v, expected %+v", chave, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateChaveAcesso_InvalidCases tests invalid access keys
+func TestValidateChaveAcesso_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		chave    interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			chave:    3524,
+			expected: "chave de acesso must be a string",
+		},
+		{
+			name:     "Too short",
+			chave:    "3524011122233300018155001000000123112345678",
+			expected: "chave de acesso must have exactly 44 digits",
+		},
+		{
+			name:     "Letters outside the emitter",
+			chave:    "3524011122233300018155001000000123112345678X",
+			expected: "chave de acesso may only have letters in the emitter CNPJ",
+		},
+		{
+			name:     "Wrong check digit",
+			chave:    "35240111222333000181550010000001231123456781",
+			expected: "invalid chave de acesso check digit",
+		},
+		{
+			name:     "Unknown UF code",
+			chave:    "99240111222333000181550010000001231123456788",
+			expected: "invalid chave de acesso UF code",
+		},
+		{
+			name:     "Invalid month",
+			chave:    "35241311222333000181550010000001231123456780",
+			expected: "invalid chave de acesso emission month",
+		},
+		{
+			name:     "Invalid emitter CNPJ",
+			chave:    "35240111222333000182550010000001231123456782",
+			expected: "invalid chave de acesso emitter CNPJ or CPF",
+		},
+		{
+			name:     "Unsupported model",
+			chave:    "35240111222333000181990010000001231123456786",
+			expected: "unsupported chave de acesso model: 99",
+		},
+		{
+			name:     "Zero emission type",
+			chave:    "35240111222333000181550010000001230123456782",
+			expected: "invalid chave de acesso emission type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateChaveAcesso(tt.chave)
+			if err == nil {
+				t.Errorf("ValidateChaveAcesso() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateChaveAcesso() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
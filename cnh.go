@@ -0,0 +1,69 @@
+// Package veritas provides Brazilian CNH validation functions.
+package veritas
+
+import (
+	"fmt"
+)
+
+// ValidateCNH validates a Brazilian CNH (Carteira Nacional de Habilitação) registro number.
+func ValidateCNH(cnh interface{}) error {
+	cnhStr, ok := cnh.(string)
+	if !ok {
+		return fmt.Errorf("CNH must be a string")
+	}
+
+	// Clean the CNH string (remove non-numeric characters)
+	cnhStr = onlyDigits(cnhStr)
+
+	// Check if CNH has exactly 11 digits
+	if len(cnhStr) != 11 {
+		return fmt.Errorf("CNH must have exactly 11 digits")
+	}
+
+	// Check for invalid sequences (all same digits)
+	if isRepeatedDigits(cnhStr) {
+		return fmt.Errorf("CNH cannot be a sequence of identical digits")
+	}
+
+	// Compare with provided check digits
+	if cnhStr[9:] != cnhCheckDigits(cnhStr[:9]) {
+		return fmt.Errorf("invalid CNH check digits")
+	}
+
+	return nil
+}
+
+// GenerateCNH returns a random valid CNH registro number, useful as a test fixture.
+func GenerateCNH() string {
+	for {
+		base := randomDigits(9)
+		if !isRepeatedDigits(base) {
+			return base + cnhCheckDigits(base)
+		}
+	}
+}
+
+// cnhCheckDigits calculates the two CNH check digits for a 9-digit base.
+func cnhCheckDigits(base string) string {
+	// First check digit uses descending weights 9..1
+	firstCheckDigit := weightedSum(base, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}) % 11
+
+	// When the first digit overflows it becomes 0 and the second digit is
+	// offset by 2, as documented by DENATRAN
+	offset := 0
+	if firstCheckDigit >= 10 {
+		firstCheckDigit = 0
+		offset = 2
+	}
+
+	// Second check digit uses ascending weights 1..9
+	secondCheckDigit := weightedSum(base, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})%11 - offset
+	if secondCheckDigit < 0 {
+		secondCheckDigit += 11
+	}
+	if secondCheckDigit >= 10 {
+		secondCheckDigit = 0
+	}
+
+	return fmt.Sprintf("%d%d", firstCheckDigit, secondCheckDigit)
+}
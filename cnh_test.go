@@ -0,0 +1,121 @@
+// Package veritas provides comprehensive unit tests for CNH validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestValidateCNH_ValidCases tests valid CNH numbers with various formats
+func TestValidateCNH_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		cnh      string
+		expected error
+	}{
+		{
+			name:     "Valid CNH without formatting",
+			cnh:      "02650306461",
+			expected: nil,
+		},
+		{
+			name:     "Valid CNH with spaces",
+			cnh:      "0446 3004 100",
+			expected: nil,
+		},
+		{
+			name:     "Valid CNH with dots and hyphen",
+			cnh:      "690.442.711-46",
+			expected: nil,
+		},
+		{
+			name:     "Valid CNH with first check digit overflow",
+			cnh:      "15205719508",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCNH(tt.cnh)
+			if err != tt.expected {
+				t.Errorf("ValidateCNH() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateCNH_InvalidCases tests invalid CNH numbers
+func TestValidateCNH_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		cnh      interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			cnh:      2650306461,
+			expected: "CNH must be a string",
+		},
+		{
+			name:     "Nil input",
+			cnh:      nil,
+			expected: "CNH must be a string",
+		},
+		{
+			name:     "CNH too short",
+			cnh:      "0265030646",
+			expected: "CNH must have exactly 11 digits",
+		},
+		{
+			name:     "CNH too long",
+			cnh:      "026503064610",
+			expected: "CNH must have exactly 11 digits",
+		},
+		{
+			name:     "Empty string",
+			cnh:      "",
+			expected: "CNH must have exactly 11 digits",
+		},
+		{
+			name:     "All ones",
+			cnh:      "11111111111",
+			expected: "CNH cannot be a sequence of identical digits",
+		},
+		{
+			name:     "Invalid first check digit",
+			cnh:      "02650306451",
+			expected: "invalid CNH check digits",
+		},
+		{
+			name:     "Invalid second check digit",
+			cnh:      "02650306462",
+			expected: "invalid CNH check digits",
+		},
+		{
+			name:     "First check digit overflow without the second digit offset",
+			cnh:      "15205719500",
+			expected: "invalid CNH check digits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCNH(tt.cnh)
+			if err == nil {
+				t.Errorf("ValidateCNH() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateCNH() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestGenerateCNH tests that generated CNH numbers are valid
+func TestGenerateCNH(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		cnh := GenerateCNH()
+		if err := ValidateCNH(cnh); err != nil {
+			t.Errorf("GenerateCNH() = %v, ValidateCNH() error = %v", cnh, err)
+		}
+	}
+}
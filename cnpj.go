@@ -4,19 +4,24 @@ package veritas
 import (
 	"fmt"
 	"regexp"
-	"strconv"
+	"strings"
 )
 
+// alphanumericCNPJ matches the alphanumeric CNPJ layout: twelve uppercase
+// letters or digits followed by two numeric check digits.
+var alphanumericCNPJ = regexp.MustCompile(`^[0-9A-Z]{12}[0-9]{2}$`)
+
 // ValidateCNPJ validates a Brazilian CNPJ (Cadastro Nacional da Pessoa Jurídica).
+// Both numeric and alphanumeric CNPJs are accepted; letters are weighted by
+// their ASCII value minus 48, as defined by the Receita Federal.
 func ValidateCNPJ(cnpj interface{}) error {
 	cnpjStr, ok := cnpj.(string)
 	if !ok {
 		return fmt.Errorf("CNPJ must be a string")
 	}
 
-	// Clean the CNPJ string (remove non-numeric characters)
-	re := regexp.MustCompile(`\D`)
-	cnpjStr = re.ReplaceAllString(cnpjStr, "")
+	// Clean the CNPJ string (remove formatting characters)
+	cnpjStr = normalizeCNPJ(cnpjStr)
 
 	// Check if CNPJ has exactly 14 digits
 	if len(cnpjStr) != 14 {
@@ -44,7 +49,7 @@ func ValidateCNPJ(cnpj interface{}) error {
 	weights1 := []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
 	sum1 := 0
 	for i, digit := range baseDigits {
-		digitValue, _ := strconv.Atoi(string(digit))
+		digitValue := int(digit - '0')
 		sum1 += digitValue * weights1[i]
 	}
 	remainder1 := sum1 % 11
@@ -57,7 +62,7 @@ func ValidateCNPJ(cnpj interface{}) error {
 	weights2 := []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
 	sum2 := 0
 	for i, digit := range baseDigits + string(rune(firstCheckDigit+'0')) {
-		digitValue, _ := strconv.Atoi(string(digit))
+		digitValue := int(digit - '0')
 		sum2 += digitValue * weights2[i]
 	}
 	remainder2 := sum2 % 11
@@ -74,3 +79,14 @@ func ValidateCNPJ(cnpj interface{}) error {
 
 	return nil
 }
+
+// normalizeCNPJ strips formatting from a CNPJ. Alphanumeric CNPJs keep their
+// letters in uppercase; any other input is reduced to its digits.
+func normalizeCNPJ(cnpj string) string {
+	alnum := regexp.MustCompile(`[^0-9A-Za-z]`).ReplaceAllString(cnpj, "")
+	alnum = strings.ToUpper(alnum)
+	if alphanumericCNPJ.MatchString(alnum) {
+		return alnum
+	}
+	return regexp.MustCompile(`\D`).ReplaceAllString(cnpj, "")
+}
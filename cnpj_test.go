@@ -238,3 +238,48 @@ func TestValidateCNPJ_TypeValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateCNPJ_Alphanumeric tests alphanumeric CNPJ numbers
+func TestValidateCNPJ_Alphanumeric(t *testing.T) {
+	tests := []struct {
+		name     string
+		cnpj     string
+		expected string
+	}{
+		{
+			name:     "Valid formatted alphanumeric CNPJ",
+			cnpj:     "12.ABC.345/01DE-35",
+			expected: "",
+		},
+		{
+			name:     "Valid lowercase alphanumeric CNPJ",
+			cnpj:     "12abc34501de35",
+			expected: "",
+		},
+		{
+			name:     "Invalid alphanumeric check digits",
+			cnpj:     "12.ABC.345/01DE-53",
+			expected: "invalid CNPJ check digits",
+		},
+		{
+			name:     "Letters in check digit positions",
+			cnpj:     "12.ABC.345/01DE-3A",
+			expected: "CNPJ must have exactly 14 digits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCNPJ(tt.cnpj)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateCNPJ() error = %v, expected nil", err)
+				}
+			} else if err == nil {
+				t.Errorf("ValidateCNPJ() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateCNPJ() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
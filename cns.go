@@ -0,0 +1,81 @@
+// Package veritas provides Brazilian CNS validation functions.
+package veritas
+
+import (
+	"fmt"
+)
+
+// CNSFamily identifies which numbering family a CNS belongs to.
+type CNSFamily string
+
+const (
+	// CNS_DEFINITIVE identifies definitive numbers, starting with 1 or 2.
+	CNS_DEFINITIVE CNSFamily = "definitive"
+	// CNS_PROVISIONAL identifies provisional numbers, starting with 7, 8 or 9.
+	CNS_PROVISIONAL CNSFamily = "provisional"
+)
+
+// cnsWeights are the weights applied to the 15 CNS digits.
+var cnsWeights = []int{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+
+// CNS is a parsed Cartão Nacional de Saúde number.
+type CNS struct {
+	Number string
+	Family CNSFamily
+}
+
+// ValidateCNS validates a Brazilian CNS (Cartão Nacional de Saúde) number.
+func ValidateCNS(cns interface{}) error {
+	_, err := ParseCNS(cns)
+	return err
+}
+
+// ParseCNS validates a CNS number and reports which family it belongs to.
+func ParseCNS(cns interface{}) (CNS, error) {
+	cnsStr, ok := cns.(string)
+	if !ok {
+		return CNS{}, fmt.Errorf("CNS must be a string")
+	}
+
+	// Clean the CNS string (remove non-numeric characters)
+	cnsStr = onlyDigits(cnsStr)
+
+	// Check if CNS has exactly 15 digits
+	if len(cnsStr) != 15 {
+		return CNS{}, fmt.Errorf("CNS must have exactly 15 digits")
+	}
+
+	switch cnsStr[0] {
+	case '1', '2':
+		if cnsStr != definitiveCNS(cnsStr[:11]) {
+			return CNS{}, fmt.Errorf("invalid CNS check digits")
+		}
+		return CNS{Number: cnsStr, Family: CNS_DEFINITIVE}, nil
+	case '7', '8', '9':
+		if weightedSum(cnsStr, cnsWeights)%11 != 0 {
+			return CNS{}, fmt.Errorf("invalid CNS check digits")
+		}
+		return CNS{Number: cnsStr, Family: CNS_PROVISIONAL}, nil
+	default:
+		return CNS{}, fmt.Errorf("CNS must start with 1, 2, 7, 8 or 9")
+	}
+}
+
+// definitiveCNS builds the definitive CNS derived from an 11-digit PIS.
+func definitiveCNS(pis string) string {
+	sum := weightedSum(pis, cnsWeights)
+	checkDigit := 11 - sum%11
+	if checkDigit == 11 {
+		checkDigit = 0
+	}
+	if checkDigit != 10 {
+		return fmt.Sprintf("%s000%d", pis, checkDigit)
+	}
+
+	// A check digit of 10 is avoided by shifting the sum with the 001 suffix
+	checkDigit = 11 - (sum+2)%11
+	if checkDigit == 11 {
+		checkDigit = 0
+	}
+	return fmt.Sprintf("%s001%d", pis, checkDigit)
+}
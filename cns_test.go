@@ -0,0 +1,104 @@
+// Package veritas provides comprehensive unit tests for CNS validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseCNS_ValidCases tests valid CNS numbers from both families
+func TestParseCNS_ValidCases(t *testing.T) {
+	tests := []struct {
+		name           string
+		cns            string
+		expectedFamily CNSFamily
+	}{
+		{
+			name:           "Definitive CNS starting with 1",
+			cns:            "100000000000007",
+			expectedFamily: CNS_DEFINITIVE,
+		},
+		{
+			name:           "Definitive CNS starting with 2 with spaces",
+			cns:            "209 6870 5620 0002",
+			expectedFamily: CNS_DEFINITIVE,
+		},
+		{
+			name:           "Definitive CNS with 001 suffix",
+			cns:            "100000000060018",
+			expectedFamily: CNS_DEFINITIVE,
+		},
+		{
+			name:           "Provisional CNS starting with 7",
+			cns:            "702 0028 8742 9583",
+			expectedFamily: CNS_PROVISIONAL,
+		},
+		{
+			name:           "Provisional CNS with zeros",
+			cns:            "700000000000005",
+			expectedFamily: CNS_PROVISIONAL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cns, err := ParseCNS(tt.cns)
+			if err != nil {
+				t.Fatalf("ParseCNS() unexpected error: %v", err)
+			}
+			if cns.Family != tt.expectedFamily {
+				t.Errorf("ParseCNS() Family = %v, expected %v", cns.Family, tt.expectedFamily)
+			}
+		})
+	}
+}
+
+// TestValidateCNS_InvalidCases tests invalid CNS numbers
+func TestValidateCNS_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		cns      interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			cns:      100000000000007,
+			expected: "CNS must be a string",
+		},
+		{
+			name:     "Too short",
+			cns:      "10000000000000",
+			expected: "CNS must have exactly 15 digits",
+		},
+		{
+			name:     "Invalid first digit",
+			cns:      "300000000000007",
+			expected: "CNS must start with 1, 2, 7, 8 or 9",
+		},
+		{
+			name:     "Definitive CNS with wrong check digit",
+			cns:      "100000000000008",
+			expected: "invalid CNS check digits",
+		},
+		{
+			name:     "Definitive CNS with wrong suffix",
+			cns:      "100000000060008",
+			expected: "invalid CNS check digits",
+		},
+		{
+			name:     "Provisional CNS with wrong weighted sum",
+			cns:      "702002887429584",
+			expected: "invalid CNS check digits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCNS(tt.cns)
+			if err == nil {
+				t.Errorf("ValidateCNS() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateCNS() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
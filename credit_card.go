@@ -0,0 +1,245 @@
+// Package veritas provides payment card validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CardBrand identifies the network of a payment card.
+type CardBrand string
+
+const (
+	// CARD_VISA identifies Visa cards.
+	CARD_VISA CardBrand = "VISA"
+	// CARD_MASTERCARD identifies Mastercard cards.
+	CARD_MASTERCARD CardBrand = "MASTERCARD"
+	// CARD_AMEX identifies American Express cards.
+	CARD_AMEX CardBrand = "AMEX"
+	// CARD_ELO identifies Elo cards.
+	CARD_ELO CardBrand = "ELO"
+	// CARD_HIPERCARD identifies Hipercard cards.
+	CARD_HIPERCARD CardBrand = "HIPERCARD"
+	// CARD_DINERS identifies Diners Club cards.
+	CARD_DINERS CardBrand = "DINERS"
+	// CARD_DISCOVER identifies Discover cards.
+	CARD_DISCOVER CardBrand = "DISCOVER"
+	// CARD_JCB identifies JCB cards.
+	CARD_JCB CardBrand = "JCB"
+)
+
+// cardIINRange is an inclusive range of card number prefixes of equal length.
+type cardIINRange struct {
+	low, high string
+}
+
+// cardBrandRule holds the IIN ranges and valid lengths of a brand.
+type cardBrandRule struct {
+	brand   CardBrand
+	ranges  []cardIINRange
+	lengths []int
+}
+
+// cardBrandRules lists the brands in detection order. Elo and Hipercard come
+// first because some of their ranges fall inside the Visa, Mastercard and
+// Discover ones.
+var cardBrandRules = []cardBrandRule{
+	{
+		brand: CARD_ELO,
+		ranges: []cardIINRange{
+			{"401178", "401179"}, {"431274", "431274"}, {"438935", "438935"}, {"451416", "451416"},
+			{"457393", "457393"}, {"457631", "457632"}, {"504175", "504175"}, {"506699", "506778"},
+			{"509000", "509999"}, {"627780", "627780"}, {"636297", "636297"}, {"636368", "636368"},
+			{"650031", "650033"}, {"650035", "650051"}, {"650405", "650439"}, {"650485", "650538"},
+			{"650541", "650598"}, {"650700", "650718"}, {"650720", "650727"}, {"650901", "650920"},
+			{"651652", "651679"}, {"655000", "655019"}, {"655021", "655058"},
+		},
+		lengths: []int{16},
+	},
+	{
+		brand: CARD_HIPERCARD,
+		ranges: []cardIINRange{
+			{"384100", "384100"}, {"384140", "384140"}, {"384160", "384160"}, {"606282", "606282"},
+			{"637095", "637095"}, {"637568", "637568"}, {"637599", "637599"}, {"637609", "637609"},
+			{"637612", "637612"},
+		},
+		lengths: []int{13, 16, 19},
+	},
+	{
+		brand:   CARD_AMEX,
+		ranges:  []cardIINRange{{"34", "34"}, {"37", "37"}},
+		lengths: []int{15},
+	},
+	{
+		brand:   CARD_DINERS,
+		ranges:  []cardIINRange{{"300", "305"}, {"36", "36"}, {"38", "39"}},
+		lengths: []int{14, 16, 19},
+	},
+	{
+		brand:   CARD_JCB,
+		ranges:  []cardIINRange{{"3528", "3589"}},
+		lengths: []int{16, 17, 18, 19},
+	},
+	{
+		brand:   CARD_DISCOVER,
+		ranges:  []cardIINRange{{"6011", "6011"}, {"622126", "622925"}, {"644", "649"}, {"65", "65"}},
+		lengths: []int{16, 17, 18, 19},
+	},
+	{
+		brand:   CARD_MASTERCARD,
+		ranges:  []cardIINRange{{"51", "55"}, {"2221", "2720"}},
+		lengths: []int{16},
+	},
+	{
+		brand:   CARD_VISA,
+		ranges:  []cardIINRange{{"4", "4"}},
+		lengths: []int{13, 16, 19},
+	},
+}
+
+var cardExpiryFormat = regexp.MustCompile(`^(\d{2})\s*/\s*(\d{2}|\d{4})$`)
+
+// CreditCard is a validated payment card number.
+type CreditCard struct {
+	Number string
+	Brand  CardBrand
+	// Masked keeps the first six and last four digits, safe for logging.
+	Masked string
+}
+
+// ValidateCreditCard validates a payment card number with the Luhn algorithm
+// and the IIN ranges and lengths of its brand. When brands are given, only
+// cards of those brands are accepted.
+func ValidateCreditCard(number interface{}, brands ...CardBrand) error {
+	card, err := ParseCreditCard(number)
+	if err != nil {
+		return err
+	}
+
+	if len(brands) == 0 {
+		return nil
+	}
+	for _, brand := range brands {
+		if brand == card.Brand {
+			return nil
+		}
+	}
+	return fmt.Errorf("card brand %s is not allowed", card.Brand)
+}
+
+// ParseCreditCard validates a payment card number and returns it with its
+// detected brand and masked form.
+func ParseCreditCard(number interface{}) (CreditCard, error) {
+	numberStr, ok := number.(string)
+	if !ok {
+		return CreditCard{}, fmt.Errorf("card number must be a string")
+	}
+
+	// Clean the card number (remove spaces and dashes)
+	numberStr = strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(numberStr))
+	if onlyDigits(numberStr) != numberStr || len(numberStr) < 12 || len(numberStr) > 19 {
+		return CreditCard{}, fmt.Errorf("card number must have between 12 and 19 digits")
+	}
+
+	// Compare with the Luhn check digit
+	if numberStr[len(numberStr)-1] != mod10CheckDigit(numberStr[:len(numberStr)-1]) {
+		return CreditCard{}, fmt.Errorf("invalid card number check digit")
+	}
+
+	rule, ok := cardBrand(numberStr)
+	if !ok {
+		return CreditCard{}, fmt.Errorf("unknown card brand")
+	}
+
+	validLength := false
+	lengths := make([]string, len(rule.lengths))
+	for i, length := range rule.lengths {
+		validLength = validLength || len(numberStr) == length
+		lengths[i] = strconv.Itoa(length)
+	}
+	if !validLength {
+		last := len(lengths) - 1
+		if last > 0 {
+			lengths = append(lengths[:last-1], lengths[last-1]+" or "+lengths[last])
+		}
+		return CreditCard{}, fmt.Errorf("%s card number must have %s digits", rule.brand, strings.Join(lengths, ", "))
+	}
+
+	return CreditCard{Number: numberStr, Brand: rule.brand, Masked: maskCardNumber(numberStr)}, nil
+}
+
+// ValidateCardExpiry validates a card expiry date in the format MM/YY or
+// MM/YYYY. A card is valid through the last day of its expiry month.
+func ValidateCardExpiry(expiry interface{}) error {
+	return validateCardExpiry(expiry, time.Now())
+}
+
+// ValidateCVV validates the security code length of a card of the given
+// brand: 4 digits for American Express and 3 for the others.
+func ValidateCVV(cvv interface{}, brand CardBrand) error {
+	cvvStr, ok := cvv.(string)
+	if !ok {
+		return fmt.Errorf("CVV must be a string")
+	}
+
+	length := 3
+	if brand == CARD_AMEX {
+		length = 4
+	}
+
+	cvvStr = strings.TrimSpace(cvvStr)
+	if len(cvvStr) != length || onlyDigits(cvvStr) != cvvStr {
+		return fmt.Errorf("CVV must have exactly %d digits", length)
+	}
+	return nil
+}
+
+// validateCardExpiry validates an expiry date relative to now.
+func validateCardExpiry(expiry interface{}, now time.Time) error {
+	expiryStr, ok := expiry.(string)
+	if !ok {
+		return fmt.Errorf("card expiry must be a string")
+	}
+
+	match := cardExpiryFormat.FindStringSubmatch(strings.TrimSpace(expiryStr))
+	if match == nil {
+		return fmt.Errorf("card expiry must be in the format MM/YY or MM/YYYY")
+	}
+
+	month, _ := strconv.Atoi(match[1])
+	year, _ := strconv.Atoi(match[2])
+	if month < 1 || month > 12 {
+		return fmt.Errorf("invalid card expiry month")
+	}
+	if len(match[2]) == 2 {
+		year += 2000
+	}
+
+	if year < now.Year() || (year == now.Year() && month < int(now.Month())) {
+		return fmt.Errorf("card is expired")
+	}
+	return nil
+}
+
+// cardBrand detects the brand of a card number by its IIN.
+func cardBrand(number string) (cardBrandRule, bool) {
+	for _, rule := range cardBrandRules {
+		for _, r := range rule.ranges {
+			if len(number) < len(r.low) {
+				continue
+			}
+			if prefix := number[:len(r.low)]; prefix >= r.low && prefix <= r.high {
+				return rule, true
+			}
+		}
+	}
+	return cardBrandRule{}, false
+}
+
+// maskCardNumber replaces all but the first six and last four digits with asterisks.
+func maskCardNumber(number string) string {
+	return number[:6] + strings.Repeat("*", len(number)-10) + number[len(number)-4:]
+}
@@ -0,0 +1,178 @@
+// Package veritas provides comprehensive unit tests for payment card validation functions.
+package veritas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseCreditCard_ValidCases tests brand detection and masking of valid card numbers
+func TestParseCreditCard_ValidCases(t *testing.T) {
+	tests := []struct {
+		name           string
+		number         string
+		expectedBrand  CardBrand
+		expectedMasked string
+	}{
+		{name: "Visa", number: "4111 1111 1111 1111", expectedBrand: CARD_VISA, expectedMasked: "411111******1111"},
+		{name: "Visa with 13 digits", number: "4222222222222", expectedBrand: CARD_VISA, expectedMasked: "422222***2222"},
+		{name: "Mastercard", number: "5555-5555-5555-4444", expectedBrand: CARD_MASTERCARD, expectedMasked: "555555******4444"},
+		{name: "Mastercard 2-series", number: "2223000048400011", expectedBrand: CARD_MASTERCARD, expectedMasked: "222300******0011"},
+		{name: "American Express", number: "378282246310005", expectedBrand: CARD_AMEX, expectedMasked: "378282*****0005"},
+		{name: "Diners Club", number: "30569309025904", expectedBrand: CARD_DINERS, expectedMasked: "305693****5904"},
+		{name: "Discover", number: "6011111111111117", expectedBrand: CARD_DISCOVER, expectedMasked: "601111******1117"},
+		{name: "JCB", number: "3530111333300000", expectedBrand: CARD_JCB, expectedMasked: "353011******0000"},
+		{name: "Elo", number: "6362970000457013", expectedBrand: CARD_ELO, expectedMasked: "636297******7013"},
+		{name: "Elo inside the Visa range", number: "4389350000000002", expectedBrand: CARD_ELO, expectedMasked: "438935******0002"},
+		{name: "Elo inside the Discover range", number: "6550000000000001", expectedBrand: CARD_ELO, expectedMasked: "655000******0001"},
+		{name: "Hipercard", number: "6062825624254001", expectedBrand: CARD_HIPERCARD, expectedMasked: "606282******4001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card, err := ParseCreditCard(tt.number)
+			if err != nil {
+				t.Fatalf("ParseCreditCard() unexpected error: %v", err)
+			}
+			if card.Brand != tt.expectedBrand {
+				t.Errorf("ParseCreditCard() Brand = %v, expected %v", card.Brand, tt.expectedBrand)
+			}
+			if card.Masked != tt.expectedMasked {
+				t.Errorf("ParseCreditCard() Masked = %v, expected %v", card.Masked, tt.expectedMasked)
+			}
+		})
+	}
+}
+
+// TestValidateCreditCard_InvalidCases tests invalid card numbers
+func TestValidateCreditCard_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		number   interface{}
+		brands   []CardBrand
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			number:   4111111111111111,
+			expected: "card number must be a string",
+		},
+		{
+			name:     "Too short",
+			number:   "41111111111",
+			expected: "card number must have between 12 and 19 digits",
+		},
+		{
+			name:     "Letters",
+			number:   "4111-1111-1111-111A",
+			expected: "card number must have between 12 and 19 digits",
+		},
+		{
+			name:     "Wrong check digit",
+			number:   "4111111111111112",
+			expected: "invalid card number check digit",
+		},
+		{
+			name:     "Unknown brand",
+			number:   "9999999999999995",
+			expected: "unknown card brand",
+		},
+		{
+			name:     "Wrong length for brand",
+			number:   "510000000000003",
+			expected: "MASTERCARD card number must have 16 digits",
+		},
+		{
+			name:     "Visa with 12 digits",
+			number:   "411111111117",
+			expected: "VISA card number must have 13, 16 or 19 digits",
+		},
+		{
+			name:     "Brand not allowed",
+			number:   "378282246310005",
+			brands:   []CardBrand{CARD_VISA, CARD_MASTERCARD},
+			expected: "card brand AMEX is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCreditCard(tt.number, tt.brands...)
+			if err == nil {
+				t.Errorf("ValidateCreditCard() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateCreditCard() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateCreditCard_AllowedBrand tests brand restriction with an allowed brand
+func TestValidateCreditCard_AllowedBrand(t *testing.T) {
+	if err := ValidateCreditCard("6362970000457013", CARD_ELO, CARD_HIPERCARD); err != nil {
+		t.Errorf("ValidateCreditCard() error = %v, expected nil", err)
+	}
+}
+
+// TestValidateCardExpiry tests expiry dates relative to a fixed date
+func TestValidateCardExpiry(t *testing.T) {
+	now := time.Date(2025, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expiry   interface{}
+		expected string
+	}{
+		{name: "Current month", expiry: "06/25", expected: ""},
+		{name: "Four digit year", expiry: "01/2030", expected: ""},
+		{name: "Spaces around slash", expiry: "12 / 25", expected: ""},
+		{name: "Previous month", expiry: "05/25", expected: "card is expired"},
+		{name: "Previous year", expiry: "12/2024", expected: "card is expired"},
+		{name: "Invalid month", expiry: "13/30", expected: "invalid card expiry month"},
+		{name: "Missing slash", expiry: "0630", expected: "card expiry must be in the format MM/YY or MM/YYYY"},
+		{name: "Integer input", expiry: 630, expected: "card expiry must be a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCardExpiry(tt.expiry, now)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("validateCardExpiry() error = %v, expected nil", err)
+				}
+			} else if err == nil || err.Error() != tt.expected {
+				t.Errorf("validateCardExpiry() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateCVV tests security code lengths per brand
+func TestValidateCVV(t *testing.T) {
+	tests := []struct {
+		name     string
+		cvv      interface{}
+		brand    CardBrand
+		expected string
+	}{
+		{name: "Visa CVV", cvv: "123", brand: CARD_VISA, expected: ""},
+		{name: "Amex CID", cvv: "1234", brand: CARD_AMEX, expected: ""},
+		{name: "Amex with three digits", cvv: "123", brand: CARD_AMEX, expected: "CVV must have exactly 4 digits"},
+		{name: "Elo with four digits", cvv: "1234", brand: CARD_ELO, expected: "CVV must have exactly 3 digits"},
+		{name: "Letters", cvv: "12a", brand: CARD_MASTERCARD, expected: "CVV must have exactly 3 digits"},
+		{name: "Integer input", cvv: 123, brand: CARD_VISA, expected: "CVV must be a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCVV(tt.cvv, tt.brand)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateCVV() error = %v, expected nil", err)
+				}
+			} else if err == nil || err.Error() != tt.expected {
+				t.Errorf("ValidateCVV() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
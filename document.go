@@ -0,0 +1,86 @@
+// Package veritas provides Brazilian taxpayer document detection and validation functions.
+package veritas
+
+import (
+	"fmt"
+)
+
+// DocumentKind identifies the type of a Brazilian taxpayer document.
+type DocumentKind string
+
+const (
+	// DOCUMENT_CPF identifies an individual taxpayer (pessoa física).
+	DOCUMENT_CPF DocumentKind = "CPF"
+	// DOCUMENT_CNPJ identifies a legal entity (pessoa jurídica).
+	DOCUMENT_CNPJ DocumentKind = "CNPJ"
+)
+
+// Document is a validated CPF or CNPJ with formatting removed.
+type Document struct {
+	Kind   DocumentKind
+	Number string
+}
+
+// ValidateDocument validates a value that may be either a CPF or a CNPJ.
+// When kinds are given, only documents of those kinds are accepted.
+func ValidateDocument(doc interface{}, kinds ...DocumentKind) error {
+	_, err := ParseDocument(doc, kinds...)
+	return err
+}
+
+// ParseDocument detects whether a value is a CPF or a CNPJ, validates it and
+// returns the detected kind with the normalized number. When kinds are given,
+// only documents of those kinds are accepted.
+func ParseDocument(doc interface{}, kinds ...DocumentKind) (Document, error) {
+	docStr, ok := doc.(string)
+	if !ok {
+		return Document{}, fmt.Errorf("document must be a string")
+	}
+
+	number := normalizeCNPJ(docStr)
+	kind, err := detectDocumentKind(number)
+	if err != nil {
+		return Document{}, err
+	}
+
+	if !isAllowedDocumentKind(kind, kinds) {
+		return Document{}, fmt.Errorf("document of type %s is not allowed", kind)
+	}
+
+	if kind == DOCUMENT_CPF {
+		err = ValidateCPF(number)
+	} else {
+		err = ValidateCNPJ(number)
+	}
+	if err != nil {
+		return Document{}, err
+	}
+
+	return Document{Kind: kind, Number: number}, nil
+}
+
+// detectDocumentKind infers the document kind from a normalized number.
+func detectDocumentKind(number string) (DocumentKind, error) {
+	switch len(number) {
+	case 11:
+		return DOCUMENT_CPF, nil
+	case 14:
+		return DOCUMENT_CNPJ, nil
+	default:
+		return "", fmt.Errorf("document must have 11 (CPF) or 14 (CNPJ) characters")
+	}
+}
+
+// isAllowedDocumentKind reports whether kind is in the allowed list.
+// An empty list allows every kind.
+func isAllowedDocumentKind(kind DocumentKind, allowed []DocumentKind) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, k := range allowed {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+// Package veritas provides comprehensive unit tests for document detection functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseDocument_ValidCases tests detection of valid CPFs and CNPJs
+func TestParseDocument_ValidCases(t *testing.T) {
+	tests := []struct {
+		name           string
+		doc            string
+		expectedKind   DocumentKind
+		expectedNumber string
+	}{
+		{
+			name:           "Formatted CPF",
+			doc:            "111.444.777-35",
+			expectedKind:   DOCUMENT_CPF,
+			expectedNumber: "11144477735",
+		},
+		{
+			name:           "Unformatted CPF",
+			doc:            "12345678909",
+			expectedKind:   DOCUMENT_CPF,
+			expectedNumber: "12345678909",
+		},
+		{
+			name:           "Formatted numeric CNPJ",
+			doc:            "11.222.333/0001-81",
+			expectedKind:   DOCUMENT_CNPJ,
+			expectedNumber: "11222333000181",
+		},
+		{
+			name:           "Formatted alphanumeric CNPJ",
+			doc:            "12.ABC.345/01DE-35",
+			expectedKind:   DOCUMENT_CNPJ,
+			expectedNumber: "12ABC34501DE35",
+		},
+		{
+			name:           "Lowercase alphanumeric CNPJ",
+			doc:            "12abc34501de35",
+			expectedKind:   DOCUMENT_CNPJ,
+			expectedNumber: "12ABC34501DE35",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := ParseDocument(tt.doc)
+			if err != nil {
+				t.Fatalf("ParseDocument() unexpected error: %v", err)
+			}
+			if doc.Kind != tt.expectedKind {
+				t.Errorf("ParseDocument() kind = %v, expected %v", doc.Kind, tt.expectedKind)
+			}
+			if doc.Number != tt.expectedNumber {
+				t.Errorf("ParseDocument() number = %v, expected %v", doc.Number, tt.expectedNumber)
+			}
+		})
+	}
+}
+
+// TestValidateDocument_InvalidCases tests invalid documents and kind restrictions
+func TestValidateDocument_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      interface{}
+		kinds    []DocumentKind
+		expected string
+	}{
+		{
+			name:     "Non-string input",
+			doc:      11144477735,
+			expected: "document must be a string",
+		},
+		{
+			name:     "Wrong length",
+			doc:      "1234567890",
+			expected: "document must have 11 (CPF) or 14 (CNPJ) characters",
+		},
+		{
+			name:     "Invalid CPF check digits",
+			doc:      "111.444.777-36",
+			expected: "invalid CPF check digits",
+		},
+		{
+			name:     "Invalid CNPJ check digits",
+			doc:      "11.222.333/0001-82",
+			expected: "invalid CNPJ check digits",
+		},
+		{
+			name:     "Invalid alphanumeric CNPJ check digits",
+			doc:      "12.ABC.345/01DE-36",
+			expected: "invalid CNPJ check digits",
+		},
+		{
+			name:     "CNPJ when only CPF is allowed",
+			doc:      "11.222.333/0001-81",
+			kinds:    []DocumentKind{DOCUMENT_CPF},
+			expected: "document of type CNPJ is not allowed",
+		},
+		{
+			name:     "CPF when only CNPJ is allowed",
+			doc:      "111.444.777-35",
+			kinds:    []DocumentKind{DOCUMENT_CNPJ},
+			expected: "document of type CPF is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDocument(tt.doc, tt.kinds...)
+			if err == nil {
+				t.Errorf("ValidateDocument() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateDocument() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateDocument_AllowedKinds tests that allowed kinds are accepted
+func TestValidateDocument_AllowedKinds(t *testing.T) {
+	if err := ValidateDocument("111.444.777-35", DOCUMENT_CPF); err != nil {
+		t.Errorf("ValidateDocument() error = %v, expected nil", err)
+	}
+	if err := ValidateDocument("11.222.333/0001-81", DOCUMENT_CPF, DOCUMENT_CNPJ); err != nil {
+		t.Errorf("ValidateDocument() error = %v, expected nil", err)
+	}
+}
@@ -4,6 +4,7 @@ package veritas
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 // ValidateEmail validates an email address format.
@@ -31,3 +32,85 @@ func ValidateEmail(email interface{}) error {
 
 	return nil
 }
+
+// EmailProvider describes how a mailbox provider maps address variants to the
+// same inbox. The first entry in Domains is the canonical domain; any other
+// entries are aliases that deliver to the same mailbox.
+type EmailProvider struct {
+	Domains      []string
+	IgnoreDots   bool
+	TagSeparator string
+}
+
+// DefaultEmailProviders returns the built-in rules for well-known providers.
+func DefaultEmailProviders() []EmailProvider {
+	return []EmailProvider{
+		{Domains: []string{"gmail.com", "googlemail.com"}, IgnoreDots: true, TagSeparator: "+"},
+		{Domains: []string{"outlook.com"}, TagSeparator: "+"},
+		{Domains: []string{"hotmail.com"}, TagSeparator: "+"},
+		{Domains: []string{"live.com"}, TagSeparator: "+"},
+		{Domains: []string{"fastmail.com", "fastmail.fm"}, TagSeparator: "+"},
+		{Domains: []string{"icloud.com", "me.com", "mac.com"}, TagSeparator: "+"},
+		{Domains: []string{"proton.me", "protonmail.com", "pm.me"}, TagSeparator: "+"},
+	}
+}
+
+// Canonicalizer reduces email addresses to a key that is identical for every
+// variant delivering to the same mailbox.
+type Canonicalizer struct {
+	providers map[string]EmailProvider
+}
+
+// NewCanonicalizer creates a Canonicalizer for the given provider rules.
+func NewCanonicalizer(providers []EmailProvider) *Canonicalizer {
+	byDomain := make(map[string]EmailProvider)
+	for _, provider := range providers {
+		for _, domain := range provider.Domains {
+			byDomain[strings.ToLower(domain)] = provider
+		}
+	}
+	return &Canonicalizer{providers: byDomain}
+}
+
+// Canonicalize validates an email address and returns its canonical key
+// using the default provider rules.
+func Canonicalize(email interface{}) (string, error) {
+	return NewCanonicalizer(DefaultEmailProviders()).Canonicalize(email)
+}
+
+// Canonicalize validates an email address and returns its canonical key.
+// Addresses from unknown providers are only lowercased and trimmed.
+func (c *Canonicalizer) Canonicalize(email interface{}) (string, error) {
+	if err := ValidateEmail(email); err != nil {
+		return "", err
+	}
+
+	cleaned := cleanString(email.(string), true)
+	at := strings.LastIndex(cleaned, "@")
+	local, domain := cleaned[:at], cleaned[at+1:]
+
+	provider, ok := c.providers[domain]
+	if !ok {
+		return cleaned, nil
+	}
+
+	local = canonicalLocalPart(local, provider)
+	if local == "" {
+		return "", fmt.Errorf("email local part is empty after canonicalization")
+	}
+
+	return local + "@" + strings.ToLower(provider.Domains[0]), nil
+}
+
+// canonicalLocalPart applies a provider's tag and dot rules to a local part.
+func canonicalLocalPart(local string, provider EmailProvider) string {
+	if provider.TagSeparator != "" {
+		if i := strings.Index(local, provider.TagSeparator); i >= 0 {
+			local = local[:i]
+		}
+	}
+	if provider.IgnoreDots {
+		local = strings.ReplaceAll(local, ".", "")
+	}
+	return local
+}
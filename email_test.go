@@ -267,3 +267,113 @@ func TestValidateEmail_TypeValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestCanonicalize_ProviderRules tests canonical keys for known providers
+func TestCanonicalize_ProviderRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    string
+		expected string
+	}{
+		{
+			name:     "Gmail with dots and plus tag",
+			email:    "J.Doe+promo@gmail.com",
+			expected: "jdoe@gmail.com",
+		},
+		{
+			name:     "Googlemail alias domain",
+			email:    "jdoe@googlemail.com",
+			expected: "jdoe@gmail.com",
+		},
+		{
+			name:     "Outlook plus tag keeps dots",
+			email:    "john.doe+news@outlook.com",
+			expected: "john.doe@outlook.com",
+		},
+		{
+			name:     "Fastmail alias domain with plus tag",
+			email:    "user+shop@fastmail.fm",
+			expected: "user@fastmail.com",
+		},
+		{
+			name:     "iCloud alias domain",
+			email:    "User@me.com",
+			expected: "user@icloud.com",
+		},
+		{
+			name:     "Unknown provider is only lowercased",
+			email:    " J.Doe+promo@Example.COM ",
+			expected: "j.doe+promo@example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Canonicalize(tt.email)
+			if err != nil {
+				t.Errorf("Canonicalize() unexpected error: %v", err)
+			} else if result != tt.expected {
+				t.Errorf("Canonicalize() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCanonicalize_InvalidCases tests errors returned by Canonicalize
+func TestCanonicalize_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		email    interface{}
+		expected string
+	}{
+		{
+			name:     "Non-string input",
+			email:    123,
+			expected: "email must be a string",
+		},
+		{
+			name:     "Invalid format",
+			email:    "userexample.com",
+			expected: "invalid email format",
+		},
+		{
+			name:     "Only a plus tag",
+			email:    "+promo@gmail.com",
+			expected: "email local part is empty after canonicalization",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Canonicalize(tt.email)
+			if err == nil {
+				t.Errorf("Canonicalize() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("Canonicalize() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestCanonicalizer_CustomProviders tests canonicalization with custom rules
+func TestCanonicalizer_CustomProviders(t *testing.T) {
+	canonicalizer := NewCanonicalizer([]EmailProvider{
+		{Domains: []string{"corp.example", "Mail.Corp.Example"}, TagSeparator: "-"},
+	})
+
+	result, err := canonicalizer.Canonicalize("first.last-billing@mail.corp.example")
+	if err != nil {
+		t.Fatalf("Canonicalize() unexpected error: %v", err)
+	}
+	if result != "first.last@corp.example" {
+		t.Errorf("Canonicalize() = %v, expected %v", result, "first.last@corp.example")
+	}
+
+	result, err = canonicalizer.Canonicalize("J.Doe+promo@gmail.com")
+	if err != nil {
+		t.Fatalf("Canonicalize() unexpected error: %v", err)
+	}
+	if result != "j.doe+promo@gmail.com" {
+		t.Errorf("Canonicalize() = %v, expected %v", result, "j.doe+promo@gmail.com")
+	}
+}
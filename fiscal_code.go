@@ -0,0 +1,264 @@
+// Package veritas provides CNAE, NCM and CFOP fiscal code validation functions.
+package veritas
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+//go:generate go run ./internal/gentables cnae ncm
+
+// defaultFiscalTables holds the tables embedded in the library.
+var defaultFiscalTables = &FiscalTables{
+	CNAE: mustLoadEmbeddedTable("tables/cnae.json", decodeCodeTable),
+	NCM:  mustLoadEmbeddedTable("tables/ncm.json", decodeCodeTable),
+	CFOP: mustLoadEmbeddedTable("tables/cfop.json", decodeCodeTable),
+}
+
+// FiscalCode is a fiscal classification code found in a code table.
+type FiscalCode struct {
+	Code        string
+	Formatted   string
+	Description string
+	// Version is the version of the table the code was found in.
+	Version string
+}
+
+// CodeTable is a versioned table of fiscal codes and their descriptions.
+type CodeTable struct {
+	version      string
+	descriptions map[string]string
+}
+
+// codeTableFile is the JSON representation of a code table. Codes may be
+// formatted; only their digits are kept.
+type codeTableFile struct {
+	Version string            `json:"version"`
+	Codes   map[string]string `json:"codes"`
+}
+
+// NewCodeTable creates a CodeTable of the given version mapping codes to
+// their descriptions.
+func NewCodeTable(version string, descriptions map[string]string) *CodeTable {
+	table := &CodeTable{version: version, descriptions: make(map[string]string, len(descriptions))}
+	for code, description := range descriptions {
+		table.descriptions[onlyDigits(code)] = description
+	}
+	return table
+}
+
+// LoadCodeTableFile creates a CodeTable from a JSON file holding a "version"
+// string and a "codes" object mapping codes to descriptions, the format of
+// the embedded tables.
+func LoadCodeTableFile(path string) (*CodeTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading code table file: %w", err)
+	}
+	return decodeCodeTable(data)
+}
+
+// Version returns the version of the table.
+func (t *CodeTable) Version() string {
+	return t.version
+}
+
+// Description returns the description of a code given as digits only.
+func (t *CodeTable) Description(code string) (string, bool) {
+	description, ok := t.descriptions[code]
+	return description, ok
+}
+
+// FiscalTables groups the CNAE, NCM and CFOP tables used for validation.
+// Replace a field with a table from LoadCodeTableFile to validate against a
+// newer version than the embedded one. Codes of a nil field are rejected.
+type FiscalTables struct {
+	CNAE *CodeTable
+	NCM  *CodeTable
+	CFOP *CodeTable
+}
+
+// DefaultFiscalTables returns a copy of the tables embedded in the library.
+func DefaultFiscalTables() *FiscalTables {
+	tables := *defaultFiscalTables
+	return &tables
+}
+
+// ValidateCNAE validates a CNAE subclass against the embedded CNAE table.
+func ValidateCNAE(cnae interface{}) error {
+	return defaultFiscalTables.ValidateCNAE(cnae)
+}
+
+// ParseCNAE validates a CNAE subclass and returns it with its description
+// from the embedded CNAE table.
+func ParseCNAE(cnae interface{}) (FiscalCode, error) {
+	return defaultFiscalTables.ParseCNAE(cnae)
+}
+
+// ValidateNCM validates an NCM code against the embedded NCM table.
+func ValidateNCM(ncm interface{}) error {
+	return defaultFiscalTables.ValidateNCM(ncm)
+}
+
+// ParseNCM validates an NCM code and returns it with its description from
+// the embedded NCM table.
+func ParseNCM(ncm interface{}) (FiscalCode, error) {
+	return defaultFiscalTables.ParseNCM(ncm)
+}
+
+// ValidateCFOP validates a CFOP code against the embedded CFOP table.
+func ValidateCFOP(cfop interface{}) error {
+	return defaultFiscalTables.ValidateCFOP(cfop)
+}
+
+// ParseCFOP validates a CFOP code and returns it with its description from
+// the embedded CFOP table.
+func ParseCFOP(cfop interface{}) (FiscalCode, error) {
+	return defaultFiscalTables.ParseCFOP(cfop)
+}
+
+// ValidateCNAE validates a CNAE subclass against the CNAE table.
+func (t *FiscalTables) ValidateCNAE(cnae interface{}) error {
+	_, err := t.ParseCNAE(cnae)
+	return err
+}
+
+// ParseCNAE validates a CNAE subclass and looks it up in the CNAE table.
+func (t *FiscalTables) ParseCNAE(cnae interface{}) (FiscalCode, error) {
+	cnaeStr, err := cleanCNAE(cnae)
+	if err != nil {
+		return FiscalCode{}, err
+	}
+	return lookupFiscalCode(t.CNAE, "CNAE", cnaeStr, applyMask(cnaeStr, "####-#/##"))
+}
+
+// ValidateNCM validates an NCM code against the NCM table.
+func (t *FiscalTables) ValidateNCM(ncm interface{}) error {
+	_, err := t.ParseNCM(ncm)
+	return err
+}
+
+// ParseNCM validates an NCM code and looks it up in the NCM table.
+func (t *FiscalTables) ParseNCM(ncm interface{}) (FiscalCode, error) {
+	ncmStr, err := cleanNCM(ncm)
+	if err != nil {
+		return FiscalCode{}, err
+	}
+	return lookupFiscalCode(t.NCM, "NCM", ncmStr, applyMask(ncmStr, "####.##.##"))
+}
+
+// ValidateCFOP validates a CFOP code against the CFOP table.
+func (t *FiscalTables) ValidateCFOP(cfop interface{}) error {
+	_, err := t.ParseCFOP(cfop)
+	return err
+}
+
+// ParseCFOP validates a CFOP code and looks it up in the CFOP table.
+func (t *FiscalTables) ParseCFOP(cfop interface{}) (FiscalCode, error) {
+	cfopStr, err := cleanCFOP(cfop)
+	if err != nil {
+		return FiscalCode{}, err
+	}
+	return lookupFiscalCode(t.CFOP, "CFOP", cfopStr, applyMask(cfopStr, "#.###"))
+}
+
+// cleanCNAE validates a 7-digit CNAE subclass such as 6201-5/01, including
+// the check digit of its class, and returns its digits.
+func cleanCNAE(cnae interface{}) (string, error) {
+	cnaeStr, ok := cnae.(string)
+	if !ok {
+		return "", fmt.Errorf("CNAE must be a string")
+	}
+
+	// Clean the CNAE string (remove non-numeric characters)
+	cnaeStr = onlyDigits(cnaeStr)
+
+	// Check if CNAE has exactly 7 digits
+	if len(cnaeStr) != 7 {
+		return "", fmt.Errorf("CNAE subclass must have exactly 7 digits")
+	}
+
+	// Compare with the class check digit
+	if cnaeStr[4] != cnaeCheckDigit(cnaeStr[:4]) {
+		return "", fmt.Errorf("invalid CNAE check digit")
+	}
+	return cnaeStr, nil
+}
+
+// cleanNCM validates an 8-digit NCM code such as 8471.30.12 and returns its
+// digits.
+func cleanNCM(ncm interface{}) (string, error) {
+	ncmStr, ok := ncm.(string)
+	if !ok {
+		return "", fmt.Errorf("NCM must be a string")
+	}
+
+	// Clean the NCM string (remove non-numeric characters)
+	ncmStr = onlyDigits(ncmStr)
+
+	// Check if NCM has exactly 8 digits
+	if len(ncmStr) != 8 {
+		return "", fmt.Errorf("NCM must have exactly 8 digits")
+	}
+	return ncmStr, nil
+}
+
+// cleanCFOP validates a 4-digit CFOP code such as 5.102 and returns its
+// digits. The first digit gives the direction and scope of the operation: 1,
+// 2 and 3 are inbound and 5, 6 and 7 outbound, within the state, between
+// states and abroad respectively.
+func cleanCFOP(cfop interface{}) (string, error) {
+	cfopStr, ok := cfop.(string)
+	if !ok {
+		return "", fmt.Errorf("CFOP must be a string")
+	}
+
+	// Clean the CFOP string (remove non-numeric characters)
+	cfopStr = onlyDigits(cfopStr)
+
+	// Check if CFOP has exactly 4 digits
+	if len(cfopStr) != 4 {
+		return "", fmt.Errorf("CFOP must have exactly 4 digits")
+	}
+
+	switch cfopStr[0] {
+	case '1', '2', '3', '5', '6', '7':
+	default:
+		return "", fmt.Errorf("CFOP must start with 1, 2, 3, 5, 6 or 7")
+	}
+	return cfopStr, nil
+}
+
+// lookupFiscalCode finds a cleaned code in table.
+func lookupFiscalCode(table *CodeTable, name, code, formatted string) (FiscalCode, error) {
+	if table == nil {
+		return FiscalCode{}, fmt.Errorf("no %s table to validate against", name)
+	}
+
+	description, ok := table.Description(code)
+	if !ok {
+		return FiscalCode{}, fmt.Errorf("unknown %s code: %s", name, formatted)
+	}
+	return FiscalCode{Code: code, Formatted: formatted, Description: description, Version: table.Version()}, nil
+}
+
+// cnaeCheckDigit calculates the check digit of a 4-digit CNAE class. The
+// digits are weighted 5, 4, 3, 2 and the digit is 11 minus the remainder by
+// 11 of the sum minus 1, where 10 becomes 0 and 11 becomes 1.
+func cnaeCheckDigit(class string) byte {
+	remainder := (weightedSum(class, []int{5, 4, 3, 2}) + 10) % 11
+	return byte('0' + (11-remainder)%10)
+}
+
+// decodeCodeTable parses the JSON representation of a code table.
+func decodeCodeTable(data []byte) (*CodeTable, error) {
+	var file codeTableFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("decoding code table file: %w", err)
+	}
+	if file.Version == "" {
+		return nil, fmt.Errorf("code table file must have a version")
+	}
+	return NewCodeTable(file.Version, file.Codes), nil
+}
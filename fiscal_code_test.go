@@ -0,0 +1,183 @@
+// Package veritas provides comprehensive unit tests for CNAE, NCM and CFOP fiscal code validation functions.
+package veritas
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseCNAE tests CNAE subclass validation
+func TestParseCNAE(t *testing.T) {
+	tests := []struct {
+		name        string
+		cnae        interface{}
+		expected    string
+		expectedErr string
+	}{
+		{name: "Formatted subclass", cnae: "6201-5/01", expected: "6201-5/01"},
+		{name: "Unformatted subclass", cnae: "4711302", expected: "4711-3/02"},
+		{name: "Class check digit 0", cnae: "6204-0/00", expected: "6204-0/00"},
+		{name: "Integer input", cnae: 6201501, expectedErr: "CNAE must be a string"},
+		{name: "Class only", cnae: "6201-5", expectedErr: "CNAE subclass must have exactly 7 digits"},
+		{name: "Wrong check digit", cnae: "6201-6/01", expectedErr: "invalid CNAE check digit"},
+		{name: "Unknown subclass", cnae: "6201-5/99", expectedErr: "unknown CNAE code: 6201-5/99"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := ParseCNAE(tt.cnae)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Errorf("ParseCNAE() error = %v, expected %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCNAE() unexpected error: %v", err)
+			}
+			if code.Formatted != tt.expected || code.Description == "" || code.Version != defaultFiscalTables.CNAE.Version() {
+				t.Errorf("ParseCNAE() = %+v, expected %v from the embedded table", code, tt.expected)
+			}
+		})
+	}
+}
+
+// TestCNAECheckDigit tests the class check digit against published classes
+func TestCNAECheckDigit(t *testing.T) {
+	classes := []string{
+		"0111-3", "0112-1", "0113-0", "0115-6", "0116-4", "0119-9", "0121-1", "0131-8",
+		"0141-5", "0151-2", "0161-0", "1011-2", "1012-1", "1013-9", "4120-4", "4321-5",
+		"4399-1", "4711-3", "4712-1", "4744-0", "4751-2", "4752-1", "4753-9", "4781-4",
+		"4930-2", "5611-2", "5620-1", "6201-5", "6202-3", "6203-1", "6204-0", "6209-1",
+		"6911-7", "7020-4", "8511-2", "8512-1", "8513-9", "8520-1", "8630-5", "9602-5",
+	}
+	for _, class := range classes {
+		if got := cnaeCheckDigit(class[:4]); got != class[5] {
+			t.Errorf("cnaeCheckDigit(%s) = %c, expected %c", class[:4], got, class[5])
+		}
+	}
+
+	for code := range defaultFiscalTables.CNAE.descriptions {
+		if code[4] != cnaeCheckDigit(code[:4]) {
+			t.Errorf("embedded CNAE %s has an invalid check digit", code)
+		}
+	}
+}
+
+// TestParseNCM tests NCM code validation
+func TestParseNCM(t *testing.T) {
+	tests := []struct {
+		name        string
+		ncm         interface{}
+		expected    string
+		expectedErr string
+	}{
+		{name: "Formatted code", ncm: "8471.30.12", expected: "8471.30.12"},
+		{name: "Unformatted code", ncm: "85171300", expected: "8517.13.00"},
+		{name: "Integer input", ncm: 85171300, expectedErr: "NCM must be a string"},
+		{name: "Heading only", ncm: "8517", expectedErr: "NCM must have exactly 8 digits"},
+		{name: "Unknown code", ncm: "0000.00.00", expectedErr: "unknown NCM code: 0000.00.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := ParseNCM(tt.ncm)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Errorf("ParseNCM() error = %v, expected %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseNCM() unexpected error: %v", err)
+			}
+			if code.Formatted != tt.expected || code.Description == "" {
+				t.Errorf("ParseNCM() = %+v, expected %v", code, tt.expected)
+			}
+		})
+	}
+}
+
+// TestParseCFOP tests CFOP code validation
+func TestParseCFOP(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfop        interface{}
+		expected    string
+		expectedErr string
+	}{
+		{name: "Formatted code", cfop: "5.102", expected: "5.102"},
+		{name: "Unformatted code", cfop: "1556", expected: "1.556"},
+		{name: "Integer input", cfop: 5102, expectedErr: "CFOP must be a string"},
+		{name: "Three digits", cfop: "510", expectedErr: "CFOP must have exactly 4 digits"},
+		{name: "Invalid first digit", cfop: "4.102", expectedErr: "CFOP must start with 1, 2, 3, 5, 6 or 7"},
+		{name: "Unknown code", cfop: "5.999", expectedErr: "unknown CFOP code: 5.999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := ParseCFOP(tt.cfop)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Errorf("ParseCFOP() error = %v, expected %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCFOP() unexpected error: %v", err)
+			}
+			if code.Formatted != tt.expected || code.Description == "" || code.Version != defaultFiscalTables.CFOP.Version() {
+				t.Errorf("ParseCFOP() = %+v, expected %v from the embedded table", code, tt.expected)
+			}
+		})
+	}
+}
+
+// TestLoadCodeTableFile tests validating against a table loaded from a file
+func TestLoadCodeTableFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cfop.json")
+	data := `{"version": "2025", "codes": {"5.998": "Código criado por uma versão futura da tabela"}}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := LoadCodeTableFile(path)
+	if err != nil {
+		t.Fatalf("LoadCodeTableFile() unexpected error: %v", err)
+	}
+
+	tables := DefaultFiscalTables()
+	tables.CFOP = table
+
+	code, err := tables.ParseCFOP("5998")
+	if err != nil || code.Version != "2025" {
+		t.Errorf("ParseCFOP() = %+v, %v, expected code from version 2025", code, err)
+	}
+	if err := tables.ValidateCFOP("5.102"); err == nil || err.Error() != "unknown CFOP code: 5.102" {
+		t.Errorf("ValidateCFOP() error = %v, expected unknown CFOP code: 5.102", err)
+	}
+	if err := tables.ValidateCNAE("6201-5/01"); err != nil {
+		t.Errorf("ValidateCNAE() with embedded table error = %v, expected nil", err)
+	}
+	if err := ValidateCFOP("5.998"); err == nil {
+		t.Errorf("ValidateCFOP() on default tables expected error after replacing a copy, got nil")
+	}
+
+	tables.NCM = nil
+	if err := tables.ValidateNCM("8471.30.12"); err == nil || err.Error() != "no NCM table to validate against" {
+		t.Errorf("ValidateNCM() without a table error = %v, expected no NCM table to validate against", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.json")
+	if err := os.WriteFile(invalid, []byte(`{"codes": {}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadCodeTableFile(invalid); err == nil || err.Error() != "code table file must have a version" {
+		t.Errorf("LoadCodeTableFile() error = %v, expected code table file must have a version", err)
+	}
+	if _, err := LoadCodeTableFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("LoadCodeTableFile() expected error for missing file, got nil")
+	}
+}
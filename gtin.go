@@ -0,0 +1,168 @@
+// Package veritas provides GTIN (EAN/UPC) barcode validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// gs1Prefix is a range of 3-digit GS1 prefixes.
+type gs1Prefix struct {
+	low, high  int
+	name       string
+	restricted bool
+}
+
+// gs1Prefixes lists the GS1 prefix ranges of GTIN-12/13/14. Restricted
+// ranges are reserved for in-store and company internal numbering, coupons
+// and refund receipts, and are not globally unique.
+var gs1Prefixes = []gs1Prefix{
+	{0, 19, "United States and Canada", false},
+	{20, 29, "Restricted distribution (in-store)", true},
+	{30, 39, "United States and Canada", false},
+	{40, 49, "Restricted distribution (company internal)", true},
+	{50, 59, "Coupons", true},
+	{60, 139, "United States and Canada", false},
+	{200, 299, "Restricted distribution (in-store)", true},
+	{300, 379, "France", false},
+	{380, 380, "Bulgaria", false},
+	{383, 383, "Slovenia", false},
+	{385, 385, "Croatia", false},
+	{387, 387, "Bosnia and Herzegovina", false},
+	{400, 440, "Germany", false},
+	{450, 459, "Japan", false},
+	{460, 469, "Russia", false},
+	{490, 499, "Japan", false},
+	{500, 509, "United Kingdom", false},
+	{520, 521, "Greece", false},
+	{540, 549, "Belgium and Luxembourg", false},
+	{560, 560, "Portugal", false},
+	{569, 569, "Iceland", false},
+	{570, 579, "Denmark", false},
+	{590, 590, "Poland", false},
+	{600, 601, "South Africa", false},
+	{690, 699, "China", false},
+	{700, 709, "Norway", false},
+	{729, 729, "Israel", false},
+	{730, 739, "Sweden", false},
+	{754, 755, "Canada", false},
+	{760, 769, "Switzerland", false},
+	{770, 771, "Colombia", false},
+	{773, 773, "Uruguay", false},
+	{775, 775, "Peru", false},
+	{778, 779, "Argentina", false},
+	{780, 780, "Chile", false},
+	{784, 784, "Paraguay", false},
+	{786, 786, "Ecuador", false},
+	{789, 790, "Brazil", false},
+	{800, 839, "Italy", false},
+	{840, 849, "Spain", false},
+	{850, 850, "Cuba", false},
+	{858, 858, "Slovakia", false},
+	{859, 859, "Czech Republic", false},
+	{869, 869, "Turkey", false},
+	{870, 879, "Netherlands", false},
+	{880, 880, "South Korea", false},
+	{890, 890, "India", false},
+	{893, 893, "Vietnam", false},
+	{899, 899, "Indonesia", false},
+	{900, 919, "Austria", false},
+	{930, 939, "Australia", false},
+	{940, 949, "New Zealand", false},
+	{955, 955, "Malaysia", false},
+	{977, 977, "Serial publications (ISSN)", false},
+	{978, 979, "Books (ISBN)", false},
+	{980, 980, "Refund receipts", true},
+	{981, 984, "Coupons", true},
+	{990, 999, "Coupons", true},
+}
+
+// GTIN is a validated Global Trade Item Number.
+type GTIN struct {
+	GTIN string
+	// Format is GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) or GTIN-14.
+	Format string
+	// GTIN14 is the number left-padded with zeros to 14 digits.
+	GTIN14 string
+	// Prefix is the 3-digit GS1 prefix and PrefixName the country or use it
+	// is assigned to; PrefixName is empty for unassigned prefixes.
+	Prefix     string
+	PrefixName string
+	// Restricted reports whether the number is for restricted circulation,
+	// such as in-store codes, and is not globally unique.
+	Restricted bool
+}
+
+// ValidateGTIN validates a GTIN-8, GTIN-12 (UPC-A), GTIN-13 (EAN-13) or GTIN-14.
+func ValidateGTIN(gtin interface{}) error {
+	_, err := ParseGTIN(gtin)
+	return err
+}
+
+// ParseGTIN validates a GTIN and returns its format, GS1 prefix and whether
+// it is restricted to internal circulation.
+func ParseGTIN(gtin interface{}) (GTIN, error) {
+	gtinStr, ok := gtin.(string)
+	if !ok {
+		return GTIN{}, fmt.Errorf("GTIN must be a string")
+	}
+
+	// Clean the GTIN string (remove non-numeric characters)
+	gtinStr = onlyDigits(gtinStr)
+
+	switch len(gtinStr) {
+	case 8, 12, 13, 14:
+	default:
+		return GTIN{}, fmt.Errorf("GTIN must have 8, 12, 13 or 14 digits")
+	}
+
+	// Compare with provided check digit
+	if gtinStr[len(gtinStr)-1] != gtinCheckDigit(gtinStr[:len(gtinStr)-1]) {
+		return GTIN{}, fmt.Errorf("invalid GTIN check digit")
+	}
+
+	parsed := GTIN{
+		GTIN:   gtinStr,
+		Format: fmt.Sprintf("GTIN-%d", len(gtinStr)),
+		GTIN14: strings.Repeat("0", 14-len(gtinStr)) + gtinStr,
+	}
+
+	if len(gtinStr) == 8 {
+		// GTIN-8 numbers starting with 0 or 2 are restricted circulation numbers
+		parsed.Prefix = gtinStr[:3]
+		parsed.Restricted = gtinStr[0] == '0' || gtinStr[0] == '2'
+		if parsed.Restricted {
+			parsed.PrefixName = "Restricted distribution (in-store)"
+			return parsed, nil
+		}
+	} else {
+		// The GS1 prefix follows the indicator digit of the GTIN-14 form
+		parsed.Prefix = parsed.GTIN14[1:4]
+	}
+
+	prefix, _ := strconv.Atoi(parsed.Prefix)
+	for _, p := range gs1Prefixes {
+		if prefix >= p.low && prefix <= p.high {
+			parsed.PrefixName = p.name
+			parsed.Restricted = p.restricted
+			break
+		}
+	}
+
+	return parsed, nil
+}
+
+// gtinCheckDigit calculates the GS1 modulo 10 check digit. Digits are
+// weighted 3, 1, 3... from the right.
+func gtinCheckDigit(digits string) byte {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		weight := 1
+		if (len(digits)-1-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(digits[i]-'0') * weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
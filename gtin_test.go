@@ -0,0 +1,185 @@
+// Package veritas provides comprehensive unit tests for GTIN validation functions.
+package veritas
+
+import "testing"
+
+// TestParseGTIN_ValidCases tests format, prefix and restriction detection of valid GTINs
+func TestParseGTIN_ValidCases(t *testing.T) {
+	tests := []struct {
+		name               string
+		gtin               string
+		expectedFormat     string
+		expectedGTIN14     string
+		expectedPrefix     string
+		expectedPrefixName string
+		expectedRestricted bool
+	}{
+		{
+			name:               "Brazilian EAN-13 (789)",
+			gtin:               "7891000315507",
+			expectedFormat:     "GTIN-13",
+			expectedGTIN14:     "07891000315507",
+			expectedPrefix:     "789",
+			expectedPrefixName: "Brazil",
+		},
+		{
+			name:               "Brazilian EAN-13 (790)",
+			gtin:               "7901234567891",
+			expectedFormat:     "GTIN-13",
+			expectedGTIN14:     "07901234567891",
+			expectedPrefix:     "790",
+			expectedPrefixName: "Brazil",
+		},
+		{
+			name:               "Formatted German EAN-13",
+			gtin:               "4 006381 333931",
+			expectedFormat:     "GTIN-13",
+			expectedGTIN14:     "04006381333931",
+			expectedPrefix:     "400",
+			expectedPrefixName: "Germany",
+		},
+		{
+			name:               "UPC-A",
+			gtin:               "036000291452",
+			expectedFormat:     "GTIN-12",
+			expectedGTIN14:     "00036000291452",
+			expectedPrefix:     "003",
+			expectedPrefixName: "United States and Canada",
+		},
+		{
+			name:               "GTIN-14 with indicator digit",
+			gtin:               "10012345678902",
+			expectedFormat:     "GTIN-14",
+			expectedGTIN14:     "10012345678902",
+			expectedPrefix:     "001",
+			expectedPrefixName: "United States and Canada",
+		},
+		{
+			name:               "GTIN-8",
+			gtin:               "96385074",
+			expectedFormat:     "GTIN-8",
+			expectedGTIN14:     "00000096385074",
+			expectedPrefix:     "963",
+			expectedPrefixName: "",
+		},
+		{
+			name:               "ISBN as EAN-13",
+			gtin:               "9780306406157",
+			expectedFormat:     "GTIN-13",
+			expectedGTIN14:     "09780306406157",
+			expectedPrefix:     "978",
+			expectedPrefixName: "Books (ISBN)",
+		},
+		{
+			name:               "In-store EAN-13 (2xx)",
+			gtin:               "2012345000001",
+			expectedFormat:     "GTIN-13",
+			expectedGTIN14:     "02012345000001",
+			expectedPrefix:     "201",
+			expectedPrefixName: "Restricted distribution (in-store)",
+			expectedRestricted: true,
+		},
+		{
+			name:               "In-store UPC-A (2)",
+			gtin:               "212345000007",
+			expectedFormat:     "GTIN-12",
+			expectedGTIN14:     "00212345000007",
+			expectedPrefix:     "021",
+			expectedPrefixName: "Restricted distribution (in-store)",
+			expectedRestricted: true,
+		},
+		{
+			name:               "In-store GTIN-8",
+			gtin:               "20123451",
+			expectedFormat:     "GTIN-8",
+			expectedGTIN14:     "00000020123451",
+			expectedPrefix:     "201",
+			expectedPrefixName: "Restricted distribution (in-store)",
+			expectedRestricted: true,
+		},
+		{
+			name:               "Refund receipt",
+			gtin:               "9800000000007",
+			expectedFormat:     "GTIN-13",
+			expectedGTIN14:     "09800000000007",
+			expectedPrefix:     "980",
+			expectedPrefixName: "Refund receipts",
+			expectedRestricted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gtin, err := ParseGTIN(tt.gtin)
+			if err != nil {
+				t.Fatalf("ParseGTIN() unexpected error: %v", err)
+			}
+			if gtin.Format != tt.expectedFormat {
+				t.Errorf("ParseGTIN() Format = %v, expected %v", gtin.Format, tt.expectedFormat)
+			}
+			if gtin.GTIN14 != tt.expectedGTIN14 {
+				t.Errorf("ParseGTIN() GTIN14 = %v, expected %v", gtin.GTIN14, tt.expectedGTIN14)
+			}
+			if gtin.Prefix != tt.expectedPrefix {
+				t.Errorf("ParseGTIN() Prefix = %v, expected %v", gtin.Prefix, tt.expectedPrefix)
+			}
+			if gtin.PrefixName != tt.expectedPrefixName {
+				t.Errorf("ParseGTIN() PrefixName = %v, expected %v", gtin.PrefixName, tt.expectedPrefixName)
+			}
+			if gtin.Restricted != tt.expectedRestricted {
+				t.Errorf("ParseGTIN() Restricted = %v, expected %v", gtin.Restricted, tt.expectedRestricted)
+			}
+		})
+	}
+}
+
+// TestValidateGTIN_InvalidCases tests invalid GTINs
+func TestValidateGTIN_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		gtin     interface{}
+		expected string
+	}{
+		{name: "Integer input", gtin: 7891000315507, expected: "GTIN must be a string"},
+		{name: "Empty string", gtin: "", expected: "GTIN must have 8, 12, 13 or 14 digits"},
+		{name: "Too short", gtin: "1234567", expected: "GTIN must have 8, 12, 13 or 14 digits"},
+		{name: "Eleven digits", gtin: "12345678901", expected: "GTIN must have 8, 12, 13 or 14 digits"},
+		{name: "Too long", gtin: "123456789012345", expected: "GTIN must have 8, 12, 13 or 14 digits"},
+		{name: "Wrong EAN-13 check digit", gtin: "7891000315508", expected: "invalid GTIN check digit"},
+		{name: "Wrong UPC-A check digit", gtin: "036000291453", expected: "invalid GTIN check digit"},
+		{name: "Wrong GTIN-8 check digit", gtin: "96385075", expected: "invalid GTIN check digit"},
+		{name: "Wrong GTIN-14 check digit", gtin: "10012345678903", expected: "invalid GTIN check digit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGTIN(tt.gtin)
+			if err == nil {
+				t.Fatalf("ValidateGTIN() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateGTIN() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGTINCheckDigit tests the GS1 modulo 10 check digit calculation
+func TestGTINCheckDigit(t *testing.T) {
+	tests := []struct {
+		digits   string
+		expected byte
+	}{
+		{"789100031550", '7'},
+		{"03600029145", '2'},
+		{"9638507", '4'},
+		{"1001234567890", '2'},
+		{"978030640615", '7'},
+	}
+
+	for _, tt := range tests {
+		if got := gtinCheckDigit(tt.digits); got != tt.expected {
+			t.Errorf("gtinCheckDigit(%q) = %c, expected %c", tt.digits, got, tt.expected)
+		}
+	}
+}
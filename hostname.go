@@ -0,0 +1,145 @@
+// Package veritas provides hostname and domain name validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// HOSTNAME_MAX_LENGTH is the maximum length of a hostname, without the
+// trailing dot.
+const HOSTNAME_MAX_LENGTH = 253
+
+// hostnameLabel matches an RFC 1123 label: letters, digits and hyphens, not
+// starting or ending with a hyphen.
+var hostnameLabel = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// PublicSuffixList returns the public suffix of a domain, such as "com.br"
+// for "www.example.com.br". The List of golang.org/x/net/publicsuffix
+// implements it.
+type PublicSuffixList interface {
+	PublicSuffix(domain string) string
+}
+
+// MemoryPublicSuffixList is a PublicSuffixList of fixed suffixes. Domains
+// under none of them have their last label as public suffix.
+type MemoryPublicSuffixList struct {
+	suffixes map[string]bool
+}
+
+// NewMemoryPublicSuffixList creates a MemoryPublicSuffixList holding the
+// given suffixes.
+func NewMemoryPublicSuffixList(suffixes []string) *MemoryPublicSuffixList {
+	list := &MemoryPublicSuffixList{suffixes: make(map[string]bool, len(suffixes))}
+	for _, suffix := range suffixes {
+		list.suffixes[strings.Trim(strings.ToLower(suffix), ".")] = true
+	}
+	return list
+}
+
+// PublicSuffix returns the longest suffix of domain in the list.
+func (l *MemoryPublicSuffixList) PublicSuffix(domain string) string {
+	labels := strings.Split(domain, ".")
+	for i := range labels {
+		if suffix := strings.Join(labels[i:], "."); l.suffixes[suffix] {
+			return suffix
+		}
+	}
+	return labels[len(labels)-1]
+}
+
+// Domain is a validated domain name.
+type Domain struct {
+	// Name is the domain in lowercase, without the trailing dot.
+	Name         string
+	PublicSuffix string
+	// Registrable is the public suffix plus one label, the part a registrant
+	// owns, such as "example.com.br" for "www.example.com.br".
+	Registrable string
+}
+
+// ValidateHostname validates a hostname per RFC 1123: labels of up to 63
+// letters, digits and hyphens, up to 253 characters in total. A single label
+// such as "localhost" and a trailing dot are accepted.
+func ValidateHostname(hostname interface{}) error {
+	hostnameStr, ok := hostname.(string)
+	if !ok {
+		return fmt.Errorf("hostname must be a string")
+	}
+	_, err := hostnameLabels(hostnameStr)
+	return err
+}
+
+// ValidateDomain validates a domain name: a hostname with at least two
+// labels.
+func ValidateDomain(domain interface{}) error {
+	_, err := ParseDomain(domain, nil)
+	return err
+}
+
+// ParseDomain validates a domain name and returns its public suffix and
+// registrable domain. With a nil suffixes list, the public suffix is the
+// last label; with a list, a domain that is itself a public suffix, such as
+// "com.br", is rejected, as is a suffix that is not made of the domain's
+// last labels.
+func ParseDomain(domain interface{}, suffixes PublicSuffixList) (Domain, error) {
+	domainStr, ok := domain.(string)
+	if !ok {
+		return Domain{}, fmt.Errorf("domain must be a string")
+	}
+
+	labels, err := hostnameLabels(domainStr)
+	if err != nil {
+		return Domain{}, err
+	}
+	if len(labels) < 2 {
+		return Domain{}, fmt.Errorf("domain must have at least two labels")
+	}
+
+	name := strings.Join(labels, ".")
+	suffix := labels[len(labels)-1]
+	if suffixes != nil {
+		suffix = suffixes.PublicSuffix(name)
+	}
+	if suffix == name {
+		return Domain{}, fmt.Errorf("domain %s is a public suffix", name)
+	}
+	// The suffix must be made of the last labels of the domain
+	if !strings.HasSuffix(name, "."+suffix) {
+		return Domain{}, fmt.Errorf("invalid public suffix %q for domain %s", suffix, name)
+	}
+
+	suffixLabels := strings.Count(suffix, ".") + 1
+	return Domain{
+		Name:         name,
+		PublicSuffix: suffix,
+		Registrable:  strings.Join(labels[len(labels)-suffixLabels-1:], "."),
+	}, nil
+}
+
+// hostnameLabels validates a hostname and returns its lowercase labels.
+func hostnameLabels(hostname string) ([]string, error) {
+	// Clean the hostname string (remove spaces, the trailing dot and use lowercase)
+	hostname = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+	if isEmpty(hostname) {
+		return nil, fmt.Errorf("hostname cannot be empty")
+	}
+	if len(hostname) > HOSTNAME_MAX_LENGTH {
+		return nil, fmt.Errorf("hostname must have at most %d characters", HOSTNAME_MAX_LENGTH)
+	}
+
+	labels := strings.Split(hostname, ".")
+	for _, label := range labels {
+		if !hostnameLabel.MatchString(label) {
+			return nil, fmt.Errorf("invalid hostname label: %q", label)
+		}
+	}
+
+	// An all-numeric top-level label would make the hostname look like an IPv4 address
+	if last := labels[len(labels)-1]; onlyDigits(last) == last {
+		return nil, fmt.Errorf("hostname top-level label must not be numeric")
+	}
+
+	return labels, nil
+}
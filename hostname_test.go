@@ -0,0 +1,194 @@
+// Package veritas provides comprehensive unit tests for hostname and domain name validation functions.
+package veritas
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestValidateHostname tests RFC 1123 hostname validation
+func TestValidateHostname(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname interface{}
+		expected string
+	}{
+		{name: "Single label", hostname: "localhost"},
+		{name: "Domain", hostname: "www.example.com.br"},
+		{name: "Uppercase", hostname: "API.Example.COM"},
+		{name: "Trailing dot", hostname: "example.com."},
+		{name: "Leading digit", hostname: "1password.com"},
+		{name: "Inner hyphens", hostname: "my-host-01.internal"},
+		{name: "Punycode", hostname: "xn--ls8h.la"},
+		{name: "63-character label", hostname: strings.Repeat("a", 63) + ".com"},
+		{name: "253 characters", hostname: strings.Repeat(strings.Repeat("a", 62)+".", 4) + "a"},
+		{name: "Integer input", hostname: 123, expected: "hostname must be a string"},
+		{name: "Empty string", hostname: " ", expected: "hostname cannot be empty"},
+		{name: "254 characters", hostname: strings.Repeat(strings.Repeat("a", 62)+".", 4) + "ab", expected: "hostname must have at most 253 characters"},
+		{name: "64-character label", hostname: strings.Repeat("a", 64) + ".com", expected: `invalid hostname label: "` + strings.Repeat("a", 64) + `"`},
+		{name: "Leading hyphen", hostname: "-host.com", expected: `invalid hostname label: "-host"`},
+		{name: "Trailing hyphen", hostname: "host-.com", expected: `invalid hostname label: "host-"`},
+		{name: "Underscore", hostname: "my_host.com", expected: `invalid hostname label: "my_host"`},
+		{name: "Empty label", hostname: "example..com", expected: `invalid hostname label: ""`},
+		{name: "Leading dot", hostname: ".example.com", expected: `invalid hostname label: ""`},
+		{name: "Space", hostname: "my host", expected: `invalid hostname label: "my host"`},
+		{name: "IPv4 address", hostname: "192.168.0.1", expected: "hostname top-level label must not be numeric"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHostname(tt.hostname)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateHostname() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateHostname() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateHostname() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateDomain tests domain validation without a public suffix list
+func TestValidateDomain(t *testing.T) {
+	tests := []struct {
+		name     string
+		domain   interface{}
+		expected string
+	}{
+		{name: "Domain", domain: "example.com"},
+		{name: "Subdomain", domain: "www.example.com.br"},
+		{name: "Integer input", domain: 1, expected: "domain must be a string"},
+		{name: "Single label", domain: "localhost", expected: "domain must have at least two labels"},
+		{name: "Invalid label", domain: "exa_mple.com", expected: `invalid hostname label: "exa_mple"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDomain(tt.domain)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateDomain() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateDomain() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateDomain() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// fixedPublicSuffixList is a PublicSuffixList returning the same suffix for
+// every domain, standing in for a misbehaving list.
+type fixedPublicSuffixList string
+
+func (l fixedPublicSuffixList) PublicSuffix(string) string {
+	return string(l)
+}
+
+// TestParseDomain tests public suffix and registrable domain detection
+func TestParseDomain(t *testing.T) {
+	suffixes := NewMemoryPublicSuffixList([]string{"com", "br", "com.br", "gov.br", ".Blog.BR."})
+
+	tests := []struct {
+		name                string
+		domain              string
+		suffixes            PublicSuffixList
+		expectedName        string
+		expectedSuffix      string
+		expectedRegistrable string
+		expectedError       string
+	}{
+		{
+			name:                "Without list",
+			domain:              "www.example.com.br",
+			expectedName:        "www.example.com.br",
+			expectedSuffix:      "br",
+			expectedRegistrable: "com.br",
+		},
+		{
+			name:                "Multi-label suffix",
+			domain:              "www.example.com.br",
+			suffixes:            suffixes,
+			expectedName:        "www.example.com.br",
+			expectedSuffix:      "com.br",
+			expectedRegistrable: "example.com.br",
+		},
+		{
+			name:                "Normalized suffix in list",
+			domain:              "Meu.Blog.br.",
+			suffixes:            suffixes,
+			expectedName:        "meu.blog.br",
+			expectedSuffix:      "blog.br",
+			expectedRegistrable: "meu.blog.br",
+		},
+		{
+			name:                "Suffix not in list",
+			domain:              "api.example.org",
+			suffixes:            suffixes,
+			expectedName:        "api.example.org",
+			expectedSuffix:      "org",
+			expectedRegistrable: "example.org",
+		},
+		{
+			name:          "Domain is a public suffix",
+			domain:        "gov.br",
+			suffixes:      suffixes,
+			expectedError: "domain gov.br is a public suffix",
+		},
+		{
+			name:          "Suffix longer than the domain",
+			domain:        "a.b",
+			suffixes:      fixedPublicSuffixList("x.y.z.w"),
+			expectedError: `invalid public suffix "x.y.z.w" for domain a.b`,
+		},
+		{
+			name:          "Suffix not aligned on labels",
+			domain:        "example.com",
+			suffixes:      fixedPublicSuffixList("le.com"),
+			expectedError: `invalid public suffix "le.com" for domain example.com`,
+		},
+		{
+			name:          "Empty suffix",
+			domain:        "example.com",
+			suffixes:      fixedPublicSuffixList(""),
+			expectedError: `invalid public suffix "" for domain example.com`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domain, err := ParseDomain(tt.domain, tt.suffixes)
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("ParseDomain() error = nil, expected %v", tt.expectedError)
+				}
+				if err.Error() != tt.expectedError {
+					t.Errorf("ParseDomain() error = %v, expected %v", err, tt.expectedError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDomain() unexpected error: %v", err)
+			}
+			if domain.Name != tt.expectedName {
+				t.Errorf("ParseDomain() Name = %v, expected %v", domain.Name, tt.expectedName)
+			}
+			if domain.PublicSuffix != tt.expectedSuffix {
+				t.Errorf("ParseDomain() PublicSuffix = %v, expected %v", domain.PublicSuffix, tt.expectedSuffix)
+			}
+			if domain.Registrable != tt.expectedRegistrable {
+				t.Errorf("ParseDomain() Registrable = %v, expected %v", domain.Registrable, tt.expectedRegistrable)
+			}
+		})
+	}
+}
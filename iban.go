@@ -0,0 +1,145 @@
+// Package veritas provides IBAN validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ibanBBANFormats maps the countries using IBAN to the structure of their
+// BBAN in the notation of the SWIFT IBAN registry: n digits, a uppercase
+// letters and c letters or digits. The IBAN length is 4 plus the BBAN length.
+var ibanBBANFormats = map[string]string{
+	"AD": "4n4n12c", "AE": "3n16n", "AL": "8n16c", "AT": "5n11n", "AZ": "4a20c",
+	"BA": "3n3n8n2n", "BE": "3n7n2n", "BG": "4a4n2n8c", "BH": "4a14c", "BR": "8n5n10n1a1c",
+	"BY": "4c4n16c", "CH": "5n12c", "CR": "4n14n", "CY": "3n5n16c", "CZ": "4n6n10n",
+	"DE": "8n10n", "DK": "4n9n1n", "DO": "4c20n", "EE": "2n2n11n1n", "EG": "4n4n17n",
+	"ES": "4n4n1n1n10n", "FI": "3n11n", "FO": "4n9n1n", "FR": "5n5n11c2n", "GB": "4a6n8n",
+	"GE": "2a16n", "GI": "4a15c", "GL": "4n9n1n", "GR": "3n4n16c", "GT": "4c20c",
+	"HR": "7n10n", "HU": "3n4n1n15n1n", "IE": "4a6n8n", "IL": "3n3n13n", "IQ": "4a3n12n",
+	"IS": "4n2n6n10n", "IT": "1a5n5n12c", "JO": "4a4n18c", "KW": "4a22c", "KZ": "3n13c",
+	"LB": "4n20c", "LC": "4a24c", "LI": "5n12c", "LT": "5n11n", "LU": "3n13c",
+	"LV": "4a13c", "MC": "5n5n11c2n", "MD": "2c18c", "ME": "3n13n2n", "MK": "3n10c2n",
+	"MR": "5n5n11n2n", "MT": "4a5n18c", "MU": "4a2n2n12n3n3a", "NL": "4a10n", "NO": "4n6n1n",
+	"PK": "4a16c", "PL": "8n16n", "PS": "4a21c", "PT": "4n4n11n2n", "QA": "4a21c",
+	"RO": "4a16c", "RS": "3n13n2n", "SA": "2n18c", "SC": "4a2n2n16n3a", "SE": "3n16n1n",
+	"SI": "5n8n2n", "SK": "4n6n10n", "SM": "1a5n5n12c", "ST": "8n11n2n", "SV": "4a20n",
+	"TL": "3n14n2n", "TN": "2n3n13n2n", "TR": "5n1n16c", "UA": "6n19c", "VA": "3n15n",
+	"VG": "4a16n", "XK": "4n10n2n",
+}
+
+// ibanBBANPatterns holds the compiled BBAN structures of ibanBBANFormats.
+var ibanBBANPatterns = compileIBANFormats(ibanBBANFormats)
+
+// IBAN is a validated International Bank Account Number.
+type IBAN struct {
+	// Electronic is the IBAN without spaces, as used in payment messages.
+	Electronic string
+	// Print is the IBAN in groups of four characters, as printed on paper.
+	Print       string
+	CountryCode string
+	CheckDigits string
+	BBAN        string
+}
+
+// ValidateIBAN validates an International Bank Account Number.
+func ValidateIBAN(iban interface{}) error {
+	_, err := ParseIBAN(iban)
+	return err
+}
+
+// ParseIBAN validates an IBAN against the length and BBAN structure of its
+// country and its ISO 7064 mod 97-10 check digits, and returns it in
+// electronic and print formats.
+func ParseIBAN(iban interface{}) (IBAN, error) {
+	ibanStr, ok := iban.(string)
+	if !ok {
+		return IBAN{}, fmt.Errorf("IBAN must be a string")
+	}
+
+	// Clean the IBAN string (remove spaces and use uppercase)
+	ibanStr = strings.ToUpper(strings.Join(strings.Fields(ibanStr), ""))
+
+	if len(ibanStr) < 4 {
+		return IBAN{}, fmt.Errorf("IBAN is too short")
+	}
+
+	countryCode, checkDigits, bban := ibanStr[:2], ibanStr[2:4], ibanStr[4:]
+	pattern, ok := ibanBBANPatterns[countryCode]
+	if !ok {
+		return IBAN{}, fmt.Errorf("unsupported IBAN country: %s", countryCode)
+	}
+
+	// Check the country length and BBAN structure
+	if length := 4 + bbanLength(ibanBBANFormats[countryCode]); len(ibanStr) != length {
+		return IBAN{}, fmt.Errorf("%s IBAN must have exactly %d characters", countryCode, length)
+	}
+	if !pattern.MatchString(bban) {
+		return IBAN{}, fmt.Errorf("invalid %s IBAN format", countryCode)
+	}
+
+	// Check the ISO 7064 mod 97-10 check digits
+	if onlyDigits(checkDigits) != checkDigits || ibanMod97(bban+countryCode+checkDigits) != 1 {
+		return IBAN{}, fmt.Errorf("invalid IBAN check digits")
+	}
+
+	return IBAN{
+		Electronic:  ibanStr,
+		Print:       formatIBAN(ibanStr),
+		CountryCode: countryCode,
+		CheckDigits: checkDigits,
+		BBAN:        bban,
+	}, nil
+}
+
+// ibanMod97 returns the remainder by 97 of a string in which letters stand
+// for two-digit numbers (A = 10 to Z = 35).
+func ibanMod97(s string) int {
+	remainder := 0
+	for _, c := range s {
+		value := int(c - '0')
+		if c >= 'A' && c <= 'Z' {
+			value = int(c-'A') + 10
+			remainder = remainder * 100 % 97
+		} else {
+			remainder = remainder * 10 % 97
+		}
+		remainder = (remainder + value) % 97
+	}
+	return remainder
+}
+
+// formatIBAN splits an electronic IBAN into groups of four characters.
+func formatIBAN(iban string) string {
+	var groups []string
+	for len(iban) > 4 {
+		groups = append(groups, iban[:4])
+		iban = iban[4:]
+	}
+	return strings.Join(append(groups, iban), " ")
+}
+
+// bbanLength returns the number of characters described by a BBAN structure.
+func bbanLength(format string) int {
+	length := 0
+	for _, part := range regexp.MustCompile(`\d+`).FindAllString(format, -1) {
+		n, _ := strconv.Atoi(part)
+		length += n
+	}
+	return length
+}
+
+// compileIBANFormats converts BBAN structures to regular expressions.
+func compileIBANFormats(formats map[string]string) map[string]*regexp.Regexp {
+	classes := map[string]string{"n": "[0-9]", "a": "[A-Z]", "c": "[0-9A-Z]"}
+	patterns := make(map[string]*regexp.Regexp, len(formats))
+	for country, format := range formats {
+		pattern := regexp.MustCompile(`(\d+)([nac])`).ReplaceAllStringFunc(format, func(part string) string {
+			return classes[part[len(part)-1:]] + "{" + part[:len(part)-1] + "}"
+		})
+		patterns[country] = regexp.MustCompile("^" + pattern + "$")
+	}
+	return patterns
+}
@@ -0,0 +1,159 @@
+// Package veritas provides comprehensive unit tests for IBAN validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseIBAN_ValidCases tests parsing and formatting of valid IBANs
+func TestParseIBAN_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		iban     string
+		expected IBAN
+	}{
+		{
+			name: "Brazil",
+			iban: "BR1800360305000010009795493C1",
+			expected: IBAN{
+				Electronic:  "BR1800360305000010009795493C1",
+				Print:       "BR18 0036 0305 0000 1000 9795 493C 1",
+				CountryCode: "BR",
+				CheckDigits: "18",
+				BBAN:        "00360305000010009795493C1",
+			},
+		},
+		{
+			name: "United Kingdom in print format",
+			iban: "GB82 WEST 1234 5698 7654 32",
+			expected: IBAN{
+				Electronic:  "GB82WEST12345698765432",
+				Print:       "GB82 WEST 1234 5698 7654 32",
+				CountryCode: "GB",
+				CheckDigits: "82",
+				BBAN:        "WEST12345698765432",
+			},
+		},
+		{
+			name: "Lowercase Germany",
+			iban: "de89 3704 0044 0532 0130 00",
+			expected: IBAN{
+				Electronic:  "DE89370400440532013000",
+				Print:       "DE89 3704 0044 0532 0130 00",
+				CountryCode: "DE",
+				CheckDigits: "89",
+				BBAN:        "370400440532013000",
+			},
+		},
+		{
+			name: "France with letters in the account",
+			iban: "FR1420041010050500013M02606",
+			expected: IBAN{
+				Electronic:  "FR1420041010050500013M02606",
+				Print:       "FR14 2004 1010 0505 0001 3M02 606",
+				CountryCode: "FR",
+				CheckDigits: "14",
+				BBAN:        "20041010050500013M02606",
+			},
+		},
+		{
+			name: "Norway, the shortest IBAN",
+			iban: "NO9386011117947",
+			expected: IBAN{
+				Electronic:  "NO9386011117947",
+				Print:       "NO93 8601 1117 947",
+				CountryCode: "NO",
+				CheckDigits: "93",
+				BBAN:        "86011117947",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iban, err := ParseIBAN(tt.iban)
+			if err != nil {
+				t.Fatalf("ParseIBAN() unexpected error: %v", err)
+			}
+			if iban != tt.expected {
+				t.Errorf("ParseIBAN() = %+v, expected %+v", iban, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateIBAN_InvalidCases tests invalid IBANs
+func TestValidateIBAN_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		iban     interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			iban:     82,
+			expected: "IBAN must be a string",
+		},
+		{
+			name:     "Too short",
+			iban:     "GB8",
+			expected: "IBAN is too short",
+		},
+		{
+			name:     "Country without IBAN",
+			iban:     "US12345678901234",
+			expected: "unsupported IBAN country: US",
+		},
+		{
+			name:     "Wrong length for Brazil",
+			iban:     "BR1800360305000010009795493C",
+			expected: "BR IBAN must have exactly 29 characters",
+		},
+		{
+			name:     "Digit in Brazilian account type",
+			iban:     "BR18003603050000100097954931C",
+			expected: "invalid BR IBAN format",
+		},
+		{
+			name:     "Letters in German account",
+			iban:     "DE89370400440532013A00",
+			expected: "invalid DE IBAN format",
+		},
+		{
+			name:     "Wrong check digits",
+			iban:     "GB82WEST12345698765433",
+			expected: "invalid IBAN check digits",
+		},
+		{
+			name:     "Letters in check digits",
+			iban:     "GBAAWEST12345698765432",
+			expected: "invalid IBAN check digits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIBAN(tt.iban)
+			if err == nil {
+				t.Errorf("ValidateIBAN() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateIBAN() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestIBANBBANFormats tests that every country structure compiles to its pattern
+func TestIBANBBANFormats(t *testing.T) {
+	for country, format := range ibanBBANFormats {
+		if !iso3166Countries[country] {
+			t.Errorf("IBAN country %s is not an ISO 3166 code", country)
+		}
+		if ibanBBANPatterns[country] == nil {
+			t.Errorf("IBAN country %s has no compiled pattern for %s", country, format)
+		}
+	}
+	if length := 4 + bbanLength(ibanBBANFormats["BR"]); length != 29 {
+		t.Errorf("BR IBAN length = %d, expected 29", length)
+	}
+}
@@ -0,0 +1,298 @@
+// Package veritas provides Brazilian Inscrição Estadual validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// IE_ISENTO is the conventional value for taxpayers exempt from state registration.
+const IE_ISENTO = "ISENTO"
+
+// ieRule describes the Inscrição Estadual layout of a single state.
+type ieRule struct {
+	// pattern matches the structure of a normalized IE.
+	pattern *regexp.Regexp
+	// template seeds generated IEs; '#' is replaced by a random digit.
+	template string
+	// complete returns the IE with its check digits recalculated.
+	complete func(ie string) string
+	// masks maps each accepted length to its display mask.
+	masks map[int]string
+}
+
+// ieRules holds the Inscrição Estadual rules for each of the 27 UFs.
+var ieRules = map[string]ieRule{
+	"AC": {regexp.MustCompile(`^01\d{11}$`), "01###########", completeIEACDF, map[int]string{13: "##.###.###/###-##"}},
+	"AL": {regexp.MustCompile(`^24[03578]\d{6}$`), "240######", ieChain(ieMod11Times10, ieWeights(9, 2)), map[int]string{9: "#########"}},
+	"AP": {regexp.MustCompile(`^03\d{7}$`), "03#######", completeIEAP, map[int]string{9: "#########"}},
+	"AM": {regexp.MustCompile(`^\d{9}$`), "#########", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "##.###.###-#"}},
+	"BA": {regexp.MustCompile(`^\d{8,9}$`), "#########", completeIEBA, map[int]string{8: "######-##", 9: "#######-##"}},
+	"CE": {regexp.MustCompile(`^\d{9}$`), "#########", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "########-#"}},
+	"DF": {regexp.MustCompile(`^07\d{11}$`), "07###########", completeIEACDF, map[int]string{13: "##.###.###/###-##"}},
+	"ES": {regexp.MustCompile(`^\d{9}$`), "#########", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "###.###.##-#"}},
+	"GO": {regexp.MustCompile(`^1[015]\d{7}$`), "10#######", completeIEGO, map[int]string{9: "##.###.###-#"}},
+	"MA": {regexp.MustCompile(`^12\d{7}$`), "12#######", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "##.###.###-#"}},
+	"MT": {regexp.MustCompile(`^\d{11}$`), "###########", ieChain(ieMod11, []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}), map[int]string{11: "##########-#"}},
+	"MS": {regexp.MustCompile(`^(28|50)\d{7}$`), "28#######", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "##.###.###-#"}},
+	"MG": {regexp.MustCompile(`^\d{13}$`), "#############", completeIEMG, map[int]string{13: "###.###.###/####"}},
+	"PA": {regexp.MustCompile(`^15\d{7}$`), "15#######", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "##-######-#"}},
+	"PB": {regexp.MustCompile(`^\d{9}$`), "#########", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "########-#"}},
+	"PR": {regexp.MustCompile(`^\d{10}$`), "##########", ieChain(ieMod11, []int{3, 2, 7, 6, 5, 4, 3, 2}, []int{4, 3, 2, 7, 6, 5, 4, 3, 2}), map[int]string{10: "###.#####-##"}},
+	"PE": {regexp.MustCompile(`^(\d{9}|\d{14})$`), "#########", completeIEPE, map[int]string{9: "#######-##", 14: "##.#.###.#######-#"}},
+	"PI": {regexp.MustCompile(`^19\d{7}$`), "19#######", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "##.###.###-#"}},
+	"RJ": {regexp.MustCompile(`^\d{8}$`), "########", ieChain(ieMod11, []int{2, 7, 6, 5, 4, 3, 2}), map[int]string{8: "##.###.##-#"}},
+	"RN": {regexp.MustCompile(`^20\d{7,8}$`), "20#######", completeIERN, map[int]string{9: "##.###.###-#", 10: "##.#.###.###-#"}},
+	"RS": {regexp.MustCompile(`^\d{10}$`), "0#########", ieChain(ieMod11, []int{2, 9, 8, 7, 6, 5, 4, 3, 2}), map[int]string{10: "###/#######"}},
+	"RO": {regexp.MustCompile(`^(\d{9}|\d{14})$`), "##############", completeIERO, map[int]string{9: "###.#####-#", 14: "#############-#"}},
+	"RR": {regexp.MustCompile(`^24\d{7}$`), "24#######", ieChain(ieMod9, []int{1, 2, 3, 4, 5, 6, 7, 8}), map[int]string{9: "########-#"}},
+	"SC": {regexp.MustCompile(`^\d{9}$`), "#########", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "###.###.###"}},
+	"SP": {regexp.MustCompile(`^(\d{12}|P\d{12})$`), "############", completeIESP, map[int]string{12: "###.###.###.###", 13: "#-########.#/###"}},
+	"SE": {regexp.MustCompile(`^\d{9}$`), "#########", ieChain(ieMod11, ieWeights(9, 2)), map[int]string{9: "########-#"}},
+	"TO": {regexp.MustCompile(`^(\d{9}|\d{2}(01|02|03|99)\d{7})$`), "#########", completeIETO, map[int]string{9: "##.###.###-#", 11: "##.##.######-#"}},
+}
+
+// ValidateIE validates a Brazilian Inscrição Estadual for the given UF.
+// The value "ISENTO" is accepted for every UF.
+func ValidateIE(ie interface{}, uf string) error {
+	_, _, err := parseIE(ie, uf)
+	return err
+}
+
+// FormatIE validates an Inscrição Estadual and formats it with the state's mask.
+// The value "ISENTO" is returned unchanged.
+func FormatIE(ie interface{}, uf string) (string, error) {
+	ieStr, rule, err := parseIE(ie, uf)
+	if err != nil || ieStr == IE_ISENTO {
+		return ieStr, err
+	}
+	return applyMask(ieStr, rule.masks[len(ieStr)]), nil
+}
+
+// GenerateIE returns a random valid Inscrição Estadual for the given UF,
+// useful as a test fixture.
+func GenerateIE(uf string) (string, error) {
+	rule, ok := ieRules[strings.ToUpper(strings.TrimSpace(uf))]
+	if !ok {
+		return "", fmt.Errorf("unsupported UF: %s", uf)
+	}
+
+	template := []byte(rule.template)
+	for i, c := range template {
+		if c == '#' {
+			template[i] = randomDigits(1)[0]
+		}
+	}
+	return rule.complete(string(template)), nil
+}
+
+// parseIE validates an Inscrição Estadual and returns it normalized along
+// with the rule of its UF.
+func parseIE(ie interface{}, uf string) (string, ieRule, error) {
+	ieStr, ok := ie.(string)
+	if !ok {
+		return "", ieRule{}, fmt.Errorf("IE must be a string")
+	}
+
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+	rule, ok := ieRules[uf]
+	if !ok {
+		return "", ieRule{}, fmt.Errorf("unsupported UF: %s", uf)
+	}
+
+	// Clean the IE string (keep digits and the SP rural "P" prefix)
+	ieStr = regexp.MustCompile(`[^0-9A-Z]`).ReplaceAllString(strings.ToUpper(ieStr), "")
+	if ieStr == IE_ISENTO {
+		return ieStr, rule, nil
+	}
+
+	if !rule.pattern.MatchString(ieStr) {
+		return "", ieRule{}, fmt.Errorf("invalid IE format for %s", uf)
+	}
+
+	if rule.complete(ieStr) != ieStr {
+		return "", ieRule{}, fmt.Errorf("invalid IE check digits")
+	}
+
+	return ieStr, rule, nil
+}
+
+// applyMask formats value using a mask where '#' is replaced by the next character.
+func applyMask(value, mask string) string {
+	var b strings.Builder
+	next := 0
+	for _, c := range mask {
+		if c == '#' {
+			b.WriteByte(value[next])
+			next++
+		} else {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// ieWeights returns descending weights from first down to last.
+func ieWeights(first, last int) []int {
+	weights := make([]int, 0, first-last+1)
+	for w := first; w >= last; w-- {
+		weights = append(weights, w)
+	}
+	return weights
+}
+
+// ieChain builds a completion function that calculates one check digit per
+// weight list. Each check digit is placed right after the digits it weighs.
+func ieChain(reduce func(sum int) int, weights ...[]int) func(string) string {
+	return func(ie string) string {
+		for _, w := range weights {
+			ie = setDigit(ie, len(w), reduce(weightedSum(ie[:len(w)], w)))
+		}
+		return ie
+	}
+}
+
+// setDigit replaces the character at pos with the given digit.
+func setDigit(s string, pos, digit int) string {
+	return s[:pos] + string(rune('0'+digit)) + s[pos+1:]
+}
+
+// ieMod11 returns 11 minus the remainder, or 0 when the remainder is 0 or 1.
+func ieMod11(sum int) int {
+	remainder := sum % 11
+	if remainder < 2 {
+		return 0
+	}
+	return 11 - remainder
+}
+
+// ieMod11Wrap returns 11 minus the remainder, subtracting 10 when it has two digits.
+func ieMod11Wrap(sum int) int {
+	checkDigit := 11 - sum%11
+	if checkDigit >= 10 {
+		checkDigit -= 10
+	}
+	return checkDigit
+}
+
+// ieMod11Times10 returns the remainder of sum*10 by 11, or 0 when it is 10.
+func ieMod11Times10(sum int) int {
+	return (sum * 10) % 11 % 10
+}
+
+// ieMod10 returns 10 minus the remainder by 10, or 0 when the remainder is 0.
+func ieMod10(sum int) int {
+	return (10 - sum%10) % 10
+}
+
+// ieMod9 returns the remainder by 9.
+func ieMod9(sum int) int {
+	return sum % 9
+}
+
+// completeIEACDF calculates the check digits for AC and DF, which share the same layout.
+func completeIEACDF(ie string) string {
+	return ieChain(ieMod11, []int{4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})(ie)
+}
+
+// completeIEAP calculates the AP check digit, whose constants depend on the number range.
+func completeIEAP(ie string) string {
+	offset, overflow := 0, 0
+	switch number := ie[:8]; {
+	case number >= "03000001" && number <= "03017000":
+		offset = 5
+	case number >= "03017001" && number <= "03019022":
+		offset, overflow = 9, 1
+	}
+
+	checkDigit := 11 - (offset+weightedSum(ie[:8], ieWeights(9, 2)))%11
+	switch checkDigit {
+	case 10:
+		checkDigit = 0
+	case 11:
+		checkDigit = overflow
+	}
+	return setDigit(ie, 8, checkDigit)
+}
+
+// completeIEBA calculates the BA check digits. The last digit is calculated
+// first, and the modulus depends on the first (8 digits) or second (9 digits) digit.
+func completeIEBA(ie string) string {
+	base := len(ie) - 2
+	reduce := ieMod10
+	if strings.ContainsRune("679", rune(ie[len(ie)-8])) {
+		reduce = ieMod11
+	}
+
+	second := reduce(weightedSum(ie[:base], ieWeights(base+1, 2)))
+	first := reduce(weightedSum(ie[:base]+string(rune('0'+second)), ieWeights(base+2, 2)))
+	return ie[:base] + fmt.Sprintf("%d%d", first, second)
+}
+
+// completeIEGO calculates the GO check digit. A remainder of 1 yields 1 for
+// numbers in the 10103105..10119997 range and 0 otherwise.
+func completeIEGO(ie string) string {
+	remainder := weightedSum(ie[:8], ieWeights(9, 2)) % 11
+	checkDigit := ieMod11(remainder)
+	if remainder == 1 && ie[:8] >= "10103105" && ie[:8] <= "10119997" {
+		checkDigit = 1
+	}
+	return setDigit(ie, 8, checkDigit)
+}
+
+// completeIEMG calculates the MG check digits. The first one sums the digits
+// of each product after inserting a zero after the municipality code.
+func completeIEMG(ie string) string {
+	padded := ie[:3] + "0" + ie[3:11]
+	sum := 0
+	for i, digit := range padded {
+		product := int(digit-'0') * (1 + i%2)
+		sum += product/10 + product%10
+	}
+	ie = setDigit(ie, 11, ieMod10(sum))
+	return setDigit(ie, 12, ieMod11(weightedSum(ie[:12], []int{3, 2, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2})))
+}
+
+// completeIEPE calculates the PE check digits for the current e-Fisco layout
+// (9 digits) and the legacy CACEPE layout (14 digits).
+func completeIEPE(ie string) string {
+	if len(ie) == 14 {
+		return ieChain(ieMod11Wrap, []int{5, 4, 3, 2, 1, 9, 8, 7, 6, 5, 4, 3, 2})(ie)
+	}
+	return ieChain(ieMod11, ieWeights(8, 2), ieWeights(9, 2))(ie)
+}
+
+// completeIERN calculates the RN check digit for 9- and 10-digit numbers.
+func completeIERN(ie string) string {
+	return ieChain(ieMod11Times10, ieWeights(len(ie), 2))(ie)
+}
+
+// completeIERO calculates the RO check digit for the current 14-digit layout
+// and the legacy 9-digit layout, which skips the municipality code.
+func completeIERO(ie string) string {
+	if len(ie) == 14 {
+		return ieChain(ieMod11Wrap, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})(ie)
+	}
+	return ieChain(ieMod11Wrap, []int{0, 0, 0, 6, 5, 4, 3, 2})(ie)
+}
+
+// completeIESP calculates the SP check digits for commercial IEs and for
+// rural producers, whose number is prefixed by "P" and has a single check digit.
+func completeIESP(ie string) string {
+	spMod11 := func(sum int) int { return sum % 11 % 10 }
+	first := []int{1, 3, 4, 5, 6, 7, 8, 10}
+	if strings.HasPrefix(ie, "P") {
+		return "P" + ieChain(spMod11, first)(ie[1:])
+	}
+	return ieChain(spMod11, first, []int{3, 2, 10, 9, 8, 7, 6, 5, 4, 3, 2})(ie)
+}
+
+// completeIETO calculates the TO check digit. The legacy 11-digit layout
+// ignores the company type in the third and fourth digits.
+func completeIETO(ie string) string {
+	if len(ie) == 11 {
+		return ieChain(ieMod11, []int{9, 8, 0, 0, 7, 6, 5, 4, 3, 2})(ie)
+	}
+	return ieChain(ieMod11, ieWeights(9, 2))(ie)
+}
@@ -0,0 +1,195 @@
+// Package veritas provides comprehensive unit tests for Inscrição Estadual validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestValidateIE_ValidCases tests valid IEs for every UF
+func TestValidateIE_ValidCases(t *testing.T) {
+	tests := []struct {
+		name string
+		ie   string
+		uf   string
+	}{
+		{name: "AC", ie: "01.004.823/001-12", uf: "AC"},
+		{name: "AL", ie: "240000048", uf: "AL"},
+		{name: "AP", ie: "030123459", uf: "AP"},
+		{name: "AM", ie: "99.999.999-0", uf: "AM"},
+		{name: "BA with 8 digits and modulus 10", ie: "123456-63", uf: "BA"},
+		{name: "BA with 9 digits and modulus 10", ie: "1000003-06", uf: "BA"},
+		{name: "CE", ie: "06000001-5", uf: "CE"},
+		{name: "DF", ie: "07.300.001/001-09", uf: "DF"},
+		{name: "ES", ie: "999.999.99-0", uf: "ES"},
+		{name: "GO", ie: "10.987.654-7", uf: "GO"},
+		{name: "MA", ie: "120000385", uf: "MA"},
+		{name: "MT", ie: "0013000001-9", uf: "MT"},
+		{name: "MS", ie: "283123451", uf: "MS"},
+		{name: "MG", ie: "062.307.904/0081", uf: "MG"},
+		{name: "PA", ie: "15-999999-5", uf: "PA"},
+		{name: "PB", ie: "06000001-5", uf: "PB"},
+		{name: "PR", ie: "123.45678-50", uf: "PR"},
+		{name: "PE e-Fisco", ie: "0321418-40", uf: "PE"},
+		{name: "PE legacy CACEPE", ie: "18.1.001.0000004-9", uf: "PE"},
+		{name: "PI", ie: "19.301.656-7", uf: "PI"},
+		{name: "RJ", ie: "99.999.99-3", uf: "RJ"},
+		{name: "RN with 9 digits", ie: "20.040.040-1", uf: "RN"},
+		{name: "RN with 10 digits", ie: "20.0.040.040-0", uf: "RN"},
+		{name: "RS", ie: "224/3658792", uf: "RS"},
+		{name: "RO current layout", ie: "0000000062521-3", uf: "RO"},
+		{name: "RO legacy layout", ie: "101.62521-3", uf: "RO"},
+		{name: "RR", ie: "24006628-1", uf: "RR"},
+		{name: "SC", ie: "251.040.852", uf: "SC"},
+		{name: "SP commercial", ie: "110.042.490.114", uf: "SP"},
+		{name: "SP rural producer", ie: "P-01100424.3/002", uf: "SP"},
+		{name: "SE", ie: "27123456-3", uf: "SE"},
+		{name: "TO current layout", ie: "29.022.783-6", uf: "TO"},
+		{name: "TO legacy layout", ie: "29.01.022783-6", uf: "TO"},
+		{name: "Lowercase UF", ie: "224/3658792", uf: "rs"},
+		{name: "Exempt taxpayer", ie: "Isento", uf: "SP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateIE(tt.ie, tt.uf); err != nil {
+				t.Errorf("ValidateIE() error = %v, expected nil", err)
+			}
+		})
+	}
+}
+
+// TestValidateIE_InvalidCases tests invalid IEs
+func TestValidateIE_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		ie       interface{}
+		uf       string
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			ie:       2243658792,
+			uf:       "RS",
+			expected: "IE must be a string",
+		},
+		{
+			name:     "Unknown UF",
+			ie:       "2243658792",
+			uf:       "XX",
+			expected: "unsupported UF: XX",
+		},
+		{
+			name:     "Wrong length",
+			ie:       "224365879",
+			uf:       "RS",
+			expected: "invalid IE format for RS",
+		},
+		{
+			name:     "Wrong prefix",
+			ie:       "020000385",
+			uf:       "MA",
+			expected: "invalid IE format for MA",
+		},
+		{
+			name:     "Invalid AL company type",
+			ie:       "241000048",
+			uf:       "AL",
+			expected: "invalid IE format for AL",
+		},
+		{
+			name:     "Invalid TO company type",
+			ie:       "29050227836",
+			uf:       "TO",
+			expected: "invalid IE format for TO",
+		},
+		{
+			name:     "Rural prefix outside SP",
+			ie:       "P011004243002",
+			uf:       "MG",
+			expected: "invalid IE format for MG",
+		},
+		{
+			name:     "Invalid RS check digit",
+			ie:       "2243658793",
+			uf:       "RS",
+			expected: "invalid IE check digits",
+		},
+		{
+			name:     "Invalid MG second check digit",
+			ie:       "0623079040082",
+			uf:       "MG",
+			expected: "invalid IE check digits",
+		},
+		{
+			name:     "Invalid SP first check digit",
+			ie:       "110042491114",
+			uf:       "SP",
+			expected: "invalid IE check digits",
+		},
+		{
+			name:     "Valid IE for another UF",
+			ie:       "123.45678-50",
+			uf:       "SC",
+			expected: "invalid IE format for SC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIE(tt.ie, tt.uf)
+			if err == nil {
+				t.Errorf("ValidateIE() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateIE() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatIE tests per-state IE formatting
+func TestFormatIE(t *testing.T) {
+	tests := []struct {
+		name     string
+		ie       string
+		uf       string
+		expected string
+	}{
+		{name: "MG", ie: "0623079040081", uf: "MG", expected: "062.307.904/0081"},
+		{name: "SP commercial", ie: "110042490114", uf: "SP", expected: "110.042.490.114"},
+		{name: "SP rural producer", ie: "p011004243002", uf: "SP", expected: "P-01100424.3/002"},
+		{name: "BA with 8 digits", ie: "12345663", uf: "BA", expected: "123456-63"},
+		{name: "RS", ie: "2243658792", uf: "RS", expected: "224/3658792"},
+		{name: "Exempt taxpayer", ie: "isento", uf: "PR", expected: "ISENTO"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FormatIE(tt.ie, tt.uf)
+			if err != nil {
+				t.Fatalf("FormatIE() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("FormatIE() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestGenerateIE tests that generated IEs are valid for every UF
+func TestGenerateIE(t *testing.T) {
+	for uf := range ieRules {
+		for i := 0; i < 50; i++ {
+			ie, err := GenerateIE(uf)
+			if err != nil {
+				t.Fatalf("GenerateIE(%s) unexpected error: %v", uf, err)
+			}
+			if err := ValidateIE(ie, uf); err != nil {
+				t.Errorf("GenerateIE(%s) = %v, ValidateIE() error = %v", uf, ie, err)
+			}
+		}
+	}
+
+	if _, err := GenerateIE("XX"); err == nil {
+		t.Errorf("GenerateIE() expected error, got nil")
+	}
+}
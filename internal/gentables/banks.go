@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// banksURL is the participant list of the Brazilian payment system (STR)
+// published by the Banco Central do Brasil.
+const banksURL = "https://www.bcb.gov.br/content/estabilidadefinanceira/str1/ParticipantesSTR.csv"
+
+// bank is an entry of tables/banks.json.
+type bank struct {
+	Code string `json:"code"`
+	ISPB string `json:"ispb"`
+	Name string `json:"name"`
+}
+
+// banks builds the bank directory from the BCB participant list.
+func banks() (any, error) {
+	data, err := fetch(banksURL)
+	if err != nil {
+		return nil, err
+	}
+	return parseBanks(data)
+}
+
+// parseBanks decodes the CSV participant list, whose columns include ISPB,
+// Nome_Reduzido and Número_Código ("n/a" for participants without a COMPE
+// code).
+func parseBanks(data []byte) ([]bank, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("decoding participant list: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("participant list is empty")
+	}
+
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	ispbColumn, ok1 := columns["ISPB"]
+	nameColumn, ok2 := columns["Nome_Reduzido"]
+	codeColumn, ok3 := columns["Número_Código"]
+	if !ok1 || !ok2 || !ok3 {
+		return nil, fmt.Errorf("unexpected participant list columns: %v", records[0])
+	}
+
+	var list []bank
+	for _, record := range records[1:] {
+		entry := bank{
+			ISPB: fmt.Sprintf("%08s", strings.TrimSpace(record[ispbColumn])),
+			Name: strings.TrimSpace(record[nameColumn]),
+		}
+		if code := strings.TrimSpace(record[codeColumn]); code != "" && code != "n/a" {
+			entry.Code = fmt.Sprintf("%03s", code)
+		}
+		list = append(list, entry)
+	}
+
+	// Participants with a COMPE code first, in code order, then by ISPB
+	slices.SortFunc(list, func(a, b bank) int {
+		if (a.Code == "") != (b.Code == "") {
+			return cmp.Compare(len(b.Code), len(a.Code))
+		}
+		return cmp.Or(cmp.Compare(a.Code, b.Code), cmp.Compare(a.ISPB, b.ISPB))
+	})
+	return list, nil
+}
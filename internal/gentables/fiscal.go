@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	// cnaeURL is the IBGE CNAE API listing every subclass.
+	cnaeURL = "https://servicodados.ibge.gov.br/api/v2/cnae/subclasses"
+	// ncmURL is the Siscomex download of the NCM table in force.
+	ncmURL = "https://portalunico.siscomex.gov.br/classif/api/publico/nomenclatura/download/json"
+)
+
+var (
+	cnaeVersion = flag.String("cnae-version", "2.3", "version of the CNAE subclasses served by the IBGE API")
+	cfopFile    = flag.String("cfop", "", "CSV file with the CFOP table of the Convênio SINIEF s/nº, one code;description per line")
+	cfopVersion = flag.String("cfop-version", "", "version of the CFOP table, such as the date of its last amendment")
+)
+
+// codeTable is the content of tables/cnae.json, tables/ncm.json and
+// tables/cfop.json.
+type codeTable struct {
+	Version string            `json:"version"`
+	Codes   map[string]string `json:"codes"`
+}
+
+// cnae builds the CNAE subclass table from the IBGE CNAE API.
+func cnae() (any, error) {
+	data, err := fetch(cnaeURL)
+	if err != nil {
+		return nil, err
+	}
+	return parseCNAE(data, *cnaeVersion)
+}
+
+// parseCNAE decodes the CNAE API response, an array of objects with the
+// 7-digit subclass in "id" and its description in "descricao".
+func parseCNAE(data []byte, version string) (codeTable, error) {
+	var subclasses []struct {
+		ID        string `json:"id"`
+		Descricao string `json:"descricao"`
+	}
+	if err := json.Unmarshal(data, &subclasses); err != nil {
+		return codeTable{}, fmt.Errorf("decoding CNAE subclasses: %w", err)
+	}
+
+	table := codeTable{Version: version, Codes: make(map[string]string, len(subclasses))}
+	for _, subclass := range subclasses {
+		if len(subclass.ID) != 7 {
+			return codeTable{}, fmt.Errorf("invalid CNAE subclass: %s", subclass.ID)
+		}
+		code := subclass.ID[:4] + "-" + subclass.ID[4:5] + "/" + subclass.ID[5:]
+		table.Codes[code] = strings.TrimSpace(subclass.Descricao)
+	}
+	if len(table.Codes) == 0 {
+		return codeTable{}, fmt.Errorf("CNAE subclass list is empty")
+	}
+	return table, nil
+}
+
+// ncm builds the NCM table from the Siscomex download.
+func ncm() (any, error) {
+	data, err := fetch(ncmURL)
+	if err != nil {
+		return nil, err
+	}
+	return parseNCM(data)
+}
+
+// parseNCM decodes the Siscomex NCM download, keeping the 8-digit codes of
+// "Nomenclaturas" and using "Data_Ultima_Atualizacao_NCM" as the version.
+// Headings and subheadings, with fewer digits, are left out.
+func parseNCM(data []byte) (codeTable, error) {
+	var download struct {
+		Version       string `json:"Data_Ultima_Atualizacao_NCM"`
+		Nomenclaturas []struct {
+			Codigo    string `json:"Codigo"`
+			Descricao string `json:"Descricao"`
+		} `json:"Nomenclaturas"`
+	}
+	if err := json.Unmarshal(data, &download); err != nil {
+		return codeTable{}, fmt.Errorf("decoding NCM table: %w", err)
+	}
+	if download.Version == "" {
+		return codeTable{}, fmt.Errorf("NCM table has no update date")
+	}
+
+	table := codeTable{Version: strings.TrimSpace(download.Version), Codes: map[string]string{}}
+	for _, item := range download.Nomenclaturas {
+		if len(strings.ReplaceAll(item.Codigo, ".", "")) != 8 {
+			continue
+		}
+		// Descriptions start with dashes giving their depth in the hierarchy
+		table.Codes[item.Codigo] = strings.TrimLeft(item.Descricao, "- ")
+	}
+	if len(table.Codes) == 0 {
+		return codeTable{}, fmt.Errorf("NCM table has no 8-digit codes")
+	}
+	return table, nil
+}
+
+// cfop builds the CFOP table from the file given with -cfop, as the CFOP
+// table is not published in a machine-readable format.
+func cfop() (any, error) {
+	if *cfopFile == "" || *cfopVersion == "" {
+		return nil, fmt.Errorf("the CFOP table needs the -cfop and -cfop-version flags")
+	}
+	data, err := os.ReadFile(*cfopFile)
+	if err != nil {
+		return nil, err
+	}
+	return parseCFOP(data, *cfopVersion)
+}
+
+// parseCFOP decodes a CSV file with one code;description record per line.
+func parseCFOP(data []byte, version string) (codeTable, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.Comma = ';'
+	reader.FieldsPerRecord = 2
+	records, err := reader.ReadAll()
+	if err != nil {
+		return codeTable{}, fmt.Errorf("decoding CFOP file: %w", err)
+	}
+
+	table := codeTable{Version: version, Codes: make(map[string]string, len(records))}
+	for _, record := range records {
+		code := strings.TrimSpace(record[0])
+		if len(strings.ReplaceAll(code, ".", "")) != 4 {
+			return codeTable{}, fmt.Errorf("invalid CFOP record: %q", record)
+		}
+		table.Codes[code] = strings.TrimSpace(record[1])
+	}
+	if len(table.Codes) == 0 {
+		return codeTable{}, fmt.Errorf("CFOP file is empty")
+	}
+	return table, nil
+}
@@ -0,0 +1,92 @@
+// Command gentables regenerates the reference tables embedded in veritas from
+// their official sources. It writes tables/<name>.json for each table named
+// on the command line and is meant to be run from the module root, as
+// go generate does:
+//
+//	go run ./internal/gentables banks
+//
+// The CFOP table is read from a local file given with -cfop, as it is not
+// published in a machine-readable format.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// generators maps each table name to the function fetching its contents.
+var generators = map[string]func() (any, error){
+	"banks":      banks,
+	"municipios": municipios,
+	"cnae":       cnae,
+	"ncm":        ncm,
+	"cfop":       cfop,
+}
+
+// client fetches the official sources.
+var client = &http.Client{Timeout: 2 * time.Minute}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: gentables [flags] table...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	for _, name := range flag.Args() {
+		generate, ok := generators[name]
+		if !ok {
+			log.Fatalf("unknown table: %s", name)
+		}
+		table, err := generate()
+		if err != nil {
+			log.Fatalf("generating %s: %v", name, err)
+		}
+		if err := writeTable(filepath.Join("tables", name+".json"), table); err != nil {
+			log.Fatalf("writing %s: %v", name, err)
+		}
+	}
+}
+
+// fetch downloads the body of url.
+func fetch(url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// writeTable writes table as indented JSON, keeping non-ASCII characters and
+// HTML special characters as they are.
+func writeTable(path string, table any) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(file)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(table); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/LcTheSecond/veritas"
+)
+
+// municipiosURL is the IBGE localities API listing every municipality.
+const municipiosURL = "https://servicodados.ibge.gov.br/api/v1/localidades/municipios"
+
+// municipio is an entry of tables/municipios.json.
+type municipio struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	UF   string `json:"uf"`
+}
+
+// municipios builds the municipality table from the IBGE localities API.
+func municipios() (any, error) {
+	data, err := fetch(municipiosURL)
+	if err != nil {
+		return nil, err
+	}
+	return parseMunicipios(data)
+}
+
+// parseMunicipios decodes the localities API response, an array of objects
+// with the numeric IBGE code in "id" and the name in "nome". The UF is taken
+// from the first two digits of the code.
+func parseMunicipios(data []byte) ([]municipio, error) {
+	var localities []struct {
+		ID   int    `json:"id"`
+		Nome string `json:"nome"`
+	}
+	if err := json.Unmarshal(data, &localities); err != nil {
+		return nil, fmt.Errorf("decoding localities: %w", err)
+	}
+	if len(localities) == 0 {
+		return nil, fmt.Errorf("localities list is empty")
+	}
+
+	list := make([]municipio, 0, len(localities))
+	for _, locality := range localities {
+		code := strconv.Itoa(locality.ID)
+		if len(code) != 7 {
+			return nil, fmt.Errorf("invalid municipality code: %s", code)
+		}
+		uf, err := veritas.UFFromIBGECode(code[:2])
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, municipio{Code: code, Name: locality.Nome, UF: uf})
+	}
+
+	slices.SortFunc(list, func(a, b municipio) int { return cmp.Compare(a.Code, b.Code) })
+	return list, nil
+}
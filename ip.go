@@ -0,0 +1,226 @@
+// Package veritas provides IP address and CIDR validation functions.
+package veritas
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+)
+
+// IPVersion is the version of an IP address.
+type IPVersion int
+
+const (
+	// IP_V4 identifies IPv4 addresses.
+	IP_V4 IPVersion = 4
+	// IP_V6 identifies IPv6 addresses.
+	IP_V6 IPVersion = 6
+)
+
+// IPClass is the kind of network an IP address belongs to.
+type IPClass string
+
+const (
+	// IP_GLOBAL identifies globally routable addresses.
+	IP_GLOBAL IPClass = "GLOBAL"
+	// IP_PRIVATE identifies private network addresses (RFC 1918, unique local
+	// IPv6 and carrier-grade NAT shared space).
+	IP_PRIVATE IPClass = "PRIVATE"
+	// IP_LOOPBACK identifies loopback addresses.
+	IP_LOOPBACK IPClass = "LOOPBACK"
+	// IP_LINK_LOCAL identifies link-local addresses.
+	IP_LINK_LOCAL IPClass = "LINK_LOCAL"
+	// IP_MULTICAST identifies multicast addresses.
+	IP_MULTICAST IPClass = "MULTICAST"
+	// IP_UNSPECIFIED identifies the unspecified addresses 0.0.0.0 and ::.
+	IP_UNSPECIFIED IPClass = "UNSPECIFIED"
+	// IP_RESERVED identifies addresses reserved for documentation,
+	// benchmarking, future use and other special purposes.
+	IP_RESERVED IPClass = "RESERVED"
+)
+
+// ipClassRange is an address range of a class.
+type ipClassRange struct {
+	prefix netip.Prefix
+	class  IPClass
+}
+
+// ipClassRanges lists the special-purpose ranges of the IANA IPv4 and IPv6
+// registries. Addresses outside them are global.
+var ipClassRanges = []ipClassRange{
+	{netip.MustParsePrefix("0.0.0.0/32"), IP_UNSPECIFIED},
+	{netip.MustParsePrefix("0.0.0.0/8"), IP_RESERVED},
+	{netip.MustParsePrefix("10.0.0.0/8"), IP_PRIVATE},
+	{netip.MustParsePrefix("100.64.0.0/10"), IP_PRIVATE},
+	{netip.MustParsePrefix("127.0.0.0/8"), IP_LOOPBACK},
+	{netip.MustParsePrefix("169.254.0.0/16"), IP_LINK_LOCAL},
+	{netip.MustParsePrefix("172.16.0.0/12"), IP_PRIVATE},
+	{netip.MustParsePrefix("192.0.0.0/24"), IP_RESERVED},
+	{netip.MustParsePrefix("192.0.2.0/24"), IP_RESERVED},
+	{netip.MustParsePrefix("192.168.0.0/16"), IP_PRIVATE},
+	{netip.MustParsePrefix("198.18.0.0/15"), IP_RESERVED},
+	{netip.MustParsePrefix("198.51.100.0/24"), IP_RESERVED},
+	{netip.MustParsePrefix("203.0.113.0/24"), IP_RESERVED},
+	{netip.MustParsePrefix("224.0.0.0/4"), IP_MULTICAST},
+	{netip.MustParsePrefix("240.0.0.0/4"), IP_RESERVED},
+	{netip.MustParsePrefix("::/128"), IP_UNSPECIFIED},
+	{netip.MustParsePrefix("::1/128"), IP_LOOPBACK},
+	{netip.MustParsePrefix("64:ff9b:1::/48"), IP_PRIVATE},
+	{netip.MustParsePrefix("100::/64"), IP_RESERVED},
+	{netip.MustParsePrefix("2001::/23"), IP_RESERVED},
+	{netip.MustParsePrefix("2001:db8::/32"), IP_RESERVED},
+	{netip.MustParsePrefix("fc00::/7"), IP_PRIVATE},
+	{netip.MustParsePrefix("fe80::/10"), IP_LINK_LOCAL},
+	{netip.MustParsePrefix("ff00::/8"), IP_MULTICAST},
+}
+
+// ipEmbeddedIPv4Range is an IPv6 range whose addresses embed an IPv4
+// address at a byte offset.
+type ipEmbeddedIPv4Range struct {
+	prefix netip.Prefix
+	offset int
+}
+
+// ipEmbeddedIPv4Ranges lists the NAT64, 6to4 and deprecated IPv4-compatible
+// ranges, whose addresses are classified by the IPv4 address they embed.
+var ipEmbeddedIPv4Ranges = []ipEmbeddedIPv4Range{
+	{netip.MustParsePrefix("64:ff9b::/96"), 12},
+	{netip.MustParsePrefix("2002::/16"), 2},
+	{netip.MustParsePrefix("::/96"), 12},
+}
+
+// IP is a validated IP address.
+type IP struct {
+	// Addr is the address; IPv4-mapped IPv6 addresses are unmapped to IPv4.
+	Addr    netip.Addr
+	Version IPVersion
+	Class   IPClass
+}
+
+// CIDR is a validated network in CIDR notation.
+type CIDR struct {
+	Prefix  netip.Prefix
+	Version IPVersion
+	// Class is the class of the network address.
+	Class IPClass
+}
+
+// ValidateIP validates an IPv4 or IPv6 address. When versions are given,
+// only addresses of those versions are accepted.
+func ValidateIP(ip interface{}, versions ...IPVersion) error {
+	parsed, err := ParseIP(ip)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+	for _, version := range versions {
+		if version == parsed.Version {
+			return nil
+		}
+	}
+	return fmt.Errorf("IPv%d addresses are not allowed", parsed.Version)
+}
+
+// ParseIP validates an IP address and returns it with its version and class.
+func ParseIP(ip interface{}) (IP, error) {
+	ipStr, ok := ip.(string)
+	if !ok {
+		return IP{}, fmt.Errorf("IP must be a string")
+	}
+
+	addr, err := netip.ParseAddr(strings.TrimSpace(ipStr))
+	if err != nil {
+		return IP{}, fmt.Errorf("invalid IP address: %s", ipStr)
+	}
+	addr = addr.Unmap()
+
+	return IP{Addr: addr, Version: ipVersion(addr), Class: ClassifyIP(addr)}, nil
+}
+
+// ClassifyIP returns the class of an address. It is the classification used
+// by ParseIP and ParseCIDR, exposed for checks such as refusing to connect
+// to non-global addresses. IPv6 addresses embedding an IPv4 address, such
+// as NAT64 and 6to4 addresses, have the class of the embedded address.
+func ClassifyIP(addr netip.Addr) IPClass {
+	addr = addr.Unmap().WithZone("")
+	if embedded, ok := embeddedIPv4(addr); ok {
+		return ClassifyIP(embedded)
+	}
+	for _, r := range ipClassRanges {
+		if r.prefix.Contains(addr) {
+			return r.class
+		}
+	}
+	return IP_GLOBAL
+}
+
+// ValidateCIDR validates a network in CIDR notation such as 10.0.0.0/8. When
+// parent networks are given, the network must be contained in one of them.
+func ValidateCIDR(cidr interface{}, within ...string) error {
+	parsed, err := ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+
+	if len(within) == 0 {
+		return nil
+	}
+	for _, parentStr := range within {
+		parent, err := netip.ParsePrefix(strings.TrimSpace(parentStr))
+		if err != nil {
+			return fmt.Errorf("invalid parent CIDR: %s", parentStr)
+		}
+		if parent.Bits() <= parsed.Prefix.Bits() && parent.Masked().Contains(parsed.Prefix.Addr()) {
+			return nil
+		}
+	}
+	return fmt.Errorf("CIDR %s is not within %s", parsed.Prefix, strings.Join(within, ", "))
+}
+
+// ParseCIDR validates a network in CIDR notation and returns it with its
+// version and class. The address must be the network address, with no host
+// bits set.
+func ParseCIDR(cidr interface{}) (CIDR, error) {
+	cidrStr, ok := cidr.(string)
+	if !ok {
+		return CIDR{}, fmt.Errorf("CIDR must be a string")
+	}
+
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidrStr))
+	if err != nil {
+		return CIDR{}, fmt.Errorf("invalid CIDR: %s", cidrStr)
+	}
+	if prefix.Masked() != prefix {
+		return CIDR{}, fmt.Errorf("CIDR has host bits set, expected %s", prefix.Masked())
+	}
+
+	return CIDR{Prefix: prefix, Version: ipVersion(prefix.Addr()), Class: ClassifyIP(prefix.Addr())}, nil
+}
+
+// embeddedIPv4 returns the IPv4 address embedded in an address of
+// ipEmbeddedIPv4Ranges. The unspecified and loopback IPv6 addresses are not
+// IPv4-compatible addresses.
+func embeddedIPv4(addr netip.Addr) (netip.Addr, bool) {
+	if !addr.Is6() || addr == netip.IPv6Unspecified() || addr == netip.IPv6Loopback() {
+		return netip.Addr{}, false
+	}
+
+	b := addr.As16()
+	for _, r := range ipEmbeddedIPv4Ranges {
+		if r.prefix.Contains(addr) {
+			return netip.AddrFrom4([4]byte(b[r.offset : r.offset+4])), true
+		}
+	}
+	return netip.Addr{}, false
+}
+
+// ipVersion returns the version of an address.
+func ipVersion(addr netip.Addr) IPVersion {
+	if addr.Is4() {
+		return IP_V4
+	}
+	return IP_V6
+}
@@ -0,0 +1,226 @@
+// Package veritas provides comprehensive unit tests for IP address and CIDR validation functions.
+package veritas
+
+import (
+	"net/netip"
+	"testing"
+)
+
+// TestParseIP_ValidCases tests version and class detection of valid IP addresses
+func TestParseIP_ValidCases(t *testing.T) {
+	tests := []struct {
+		name            string
+		ip              string
+		expectedAddr    string
+		expectedVersion IPVersion
+		expectedClass   IPClass
+	}{
+		{name: "Global IPv4", ip: "8.8.8.8", expectedAddr: "8.8.8.8", expectedVersion: IP_V4, expectedClass: IP_GLOBAL},
+		{name: "Private 10/8", ip: "10.1.2.3", expectedAddr: "10.1.2.3", expectedVersion: IP_V4, expectedClass: IP_PRIVATE},
+		{name: "Private 172.16/12", ip: "172.31.255.255", expectedAddr: "172.31.255.255", expectedVersion: IP_V4, expectedClass: IP_PRIVATE},
+		{name: "Just outside 172.16/12", ip: "172.32.0.1", expectedAddr: "172.32.0.1", expectedVersion: IP_V4, expectedClass: IP_GLOBAL},
+		{name: "Private 192.168/16", ip: "192.168.0.1", expectedAddr: "192.168.0.1", expectedVersion: IP_V4, expectedClass: IP_PRIVATE},
+		{name: "Carrier-grade NAT", ip: "100.64.0.1", expectedAddr: "100.64.0.1", expectedVersion: IP_V4, expectedClass: IP_PRIVATE},
+		{name: "IPv4 loopback", ip: "127.0.0.1", expectedAddr: "127.0.0.1", expectedVersion: IP_V4, expectedClass: IP_LOOPBACK},
+		{name: "IPv4 link-local", ip: "169.254.169.254", expectedAddr: "169.254.169.254", expectedVersion: IP_V4, expectedClass: IP_LINK_LOCAL},
+		{name: "IPv4 multicast", ip: "224.0.0.251", expectedAddr: "224.0.0.251", expectedVersion: IP_V4, expectedClass: IP_MULTICAST},
+		{name: "IPv4 unspecified", ip: "0.0.0.0", expectedAddr: "0.0.0.0", expectedVersion: IP_V4, expectedClass: IP_UNSPECIFIED},
+		{name: "IPv4 documentation", ip: "192.0.2.10", expectedAddr: "192.0.2.10", expectedVersion: IP_V4, expectedClass: IP_RESERVED},
+		{name: "IPv4 broadcast", ip: "255.255.255.255", expectedAddr: "255.255.255.255", expectedVersion: IP_V4, expectedClass: IP_RESERVED},
+		{name: "Global IPv6", ip: "2001:4860:4860::8888", expectedAddr: "2001:4860:4860::8888", expectedVersion: IP_V6, expectedClass: IP_GLOBAL},
+		{name: "IPv6 unique local", ip: "fd12:3456:789a::1", expectedAddr: "fd12:3456:789a::1", expectedVersion: IP_V6, expectedClass: IP_PRIVATE},
+		{name: "IPv6 loopback", ip: "::1", expectedAddr: "::1", expectedVersion: IP_V6, expectedClass: IP_LOOPBACK},
+		{name: "IPv6 link-local with zone", ip: "fe80::1%eth0", expectedAddr: "fe80::1%eth0", expectedVersion: IP_V6, expectedClass: IP_LINK_LOCAL},
+		{name: "IPv6 multicast", ip: "ff02::1", expectedAddr: "ff02::1", expectedVersion: IP_V6, expectedClass: IP_MULTICAST},
+		{name: "IPv6 unspecified", ip: "::", expectedAddr: "::", expectedVersion: IP_V6, expectedClass: IP_UNSPECIFIED},
+		{name: "IPv6 documentation", ip: "2001:db8::1", expectedAddr: "2001:db8::1", expectedVersion: IP_V6, expectedClass: IP_RESERVED},
+		{name: "IPv4-mapped IPv6 is unmapped", ip: "::ffff:127.0.0.1", expectedAddr: "127.0.0.1", expectedVersion: IP_V4, expectedClass: IP_LOOPBACK},
+		{name: "Surrounding spaces", ip: " 8.8.4.4 ", expectedAddr: "8.8.4.4", expectedVersion: IP_V4, expectedClass: IP_GLOBAL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, err := ParseIP(tt.ip)
+			if err != nil {
+				t.Fatalf("ParseIP() unexpected error: %v", err)
+			}
+			if ip.Addr.String() != tt.expectedAddr {
+				t.Errorf("ParseIP() Addr = %v, expected %v", ip.Addr, tt.expectedAddr)
+			}
+			if ip.Version != tt.expectedVersion {
+				t.Errorf("ParseIP() Version = %v, expected %v", ip.Version, tt.expectedVersion)
+			}
+			if ip.Class != tt.expectedClass {
+				t.Errorf("ParseIP() Class = %v, expected %v", ip.Class, tt.expectedClass)
+			}
+		})
+	}
+}
+
+// TestValidateIP tests IP validation with and without version restrictions
+func TestValidateIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		ip       interface{}
+		versions []IPVersion
+		expected string
+	}{
+		{name: "IPv4 without restriction", ip: "192.168.0.1"},
+		{name: "IPv6 without restriction", ip: "::1"},
+		{name: "IPv4 restricted to IPv4", ip: "192.168.0.1", versions: []IPVersion{IP_V4}},
+		{name: "IPv6 restricted to both", ip: "::1", versions: []IPVersion{IP_V4, IP_V6}},
+		{name: "IPv6 restricted to IPv4", ip: "::1", versions: []IPVersion{IP_V4}, expected: "IPv6 addresses are not allowed"},
+		{name: "IPv4 restricted to IPv6", ip: "10.0.0.1", versions: []IPVersion{IP_V6}, expected: "IPv4 addresses are not allowed"},
+		{name: "Integer input", ip: 3232235521, expected: "IP must be a string"},
+		{name: "Empty string", ip: "", expected: "invalid IP address: "},
+		{name: "Octet out of range", ip: "256.1.1.1", expected: "invalid IP address: 256.1.1.1"},
+		{name: "Leading zeros", ip: "010.0.0.1", expected: "invalid IP address: 010.0.0.1"},
+		{name: "Too few octets", ip: "10.0.1", expected: "invalid IP address: 10.0.1"},
+		{name: "Hostname", ip: "example.com", expected: "invalid IP address: example.com"},
+		{name: "CIDR", ip: "10.0.0.0/8", expected: "invalid IP address: 10.0.0.0/8"},
+		{name: "Double IPv6 compression", ip: "2001::db8::1", expected: "invalid IP address: 2001::db8::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIP(tt.ip, tt.versions...)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateIP() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateIP() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateIP() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestClassifyIP tests classification of addresses built outside ParseIP
+func TestClassifyIP(t *testing.T) {
+	tests := []struct {
+		addr     netip.Addr
+		expected IPClass
+	}{
+		{netip.AddrFrom4([4]byte{10, 0, 0, 1}), IP_PRIVATE},
+		{netip.MustParseAddr("::ffff:10.0.0.1"), IP_PRIVATE},
+		{netip.MustParseAddr("fe80::1%eth0"), IP_LINK_LOCAL},
+		{netip.MustParseAddr("1.1.1.1"), IP_GLOBAL},
+		{netip.MustParseAddr("::"), IP_UNSPECIFIED},
+		{netip.MustParseAddr("::1"), IP_LOOPBACK},
+		{netip.MustParseAddr("64:ff9b::7f00:1"), IP_LOOPBACK},
+		{netip.MustParseAddr("64:ff9b::a00:1"), IP_PRIVATE},
+		{netip.MustParseAddr("64:ff9b::808:808"), IP_GLOBAL},
+		{netip.MustParseAddr("2002:7f00:1::"), IP_LOOPBACK},
+		{netip.MustParseAddr("2002:c0a8:101::1"), IP_PRIVATE},
+		{netip.MustParseAddr("2002:808:808::1"), IP_GLOBAL},
+		{netip.MustParseAddr("::127.0.0.1"), IP_LOOPBACK},
+		{netip.MustParseAddr("::169.254.0.1"), IP_LINK_LOCAL},
+		{netip.MustParseAddr("2001::1"), IP_RESERVED},
+		{netip.MustParseAddr("2001:1ff::1"), IP_RESERVED},
+		{netip.MustParseAddr("2001:200::1"), IP_GLOBAL},
+	}
+
+	for _, tt := range tests {
+		if got := ClassifyIP(tt.addr); got != tt.expected {
+			t.Errorf("ClassifyIP(%v) = %v, expected %v", tt.addr, got, tt.expected)
+		}
+	}
+}
+
+// TestParseCIDR_ValidCases tests version and class detection of valid networks
+func TestParseCIDR_ValidCases(t *testing.T) {
+	tests := []struct {
+		name            string
+		cidr            string
+		expectedVersion IPVersion
+		expectedClass   IPClass
+	}{
+		{name: "Private IPv4 network", cidr: "10.0.0.0/8", expectedVersion: IP_V4, expectedClass: IP_PRIVATE},
+		{name: "Global IPv4 network", cidr: "8.8.8.0/24", expectedVersion: IP_V4, expectedClass: IP_GLOBAL},
+		{name: "Single IPv4 address", cidr: "192.168.1.1/32", expectedVersion: IP_V4, expectedClass: IP_PRIVATE},
+		{name: "IPv4 default route", cidr: "0.0.0.0/0", expectedVersion: IP_V4, expectedClass: IP_UNSPECIFIED},
+		{name: "IPv6 network", cidr: "2001:db8::/32", expectedVersion: IP_V6, expectedClass: IP_RESERVED},
+		{name: "IPv6 unique local network", cidr: "fd00::/8", expectedVersion: IP_V6, expectedClass: IP_PRIVATE},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cidr, err := ParseCIDR(tt.cidr)
+			if err != nil {
+				t.Fatalf("ParseCIDR() unexpected error: %v", err)
+			}
+			if cidr.Prefix.String() != tt.cidr {
+				t.Errorf("ParseCIDR() Prefix = %v, expected %v", cidr.Prefix, tt.cidr)
+			}
+			if cidr.Version != tt.expectedVersion {
+				t.Errorf("ParseCIDR() Version = %v, expected %v", cidr.Version, tt.expectedVersion)
+			}
+			if cidr.Class != tt.expectedClass {
+				t.Errorf("ParseCIDR() Class = %v, expected %v", cidr.Class, tt.expectedClass)
+			}
+		})
+	}
+}
+
+// TestValidateCIDR tests CIDR validation and containment checks
+func TestValidateCIDR(t *testing.T) {
+	tests := []struct {
+		name     string
+		cidr     interface{}
+		within   []string
+		expected string
+	}{
+		{name: "Without parents", cidr: "10.1.0.0/16"},
+		{name: "Within parent", cidr: "10.1.0.0/16", within: []string{"10.0.0.0/8"}},
+		{name: "Equal to parent", cidr: "10.0.0.0/8", within: []string{"10.0.0.0/8"}},
+		{name: "Within second parent", cidr: "192.168.10.0/24", within: []string{"10.0.0.0/8", "192.168.0.0/16"}},
+		{name: "Within IPv6 parent", cidr: "fd00:1::/32", within: []string{"fd00::/8"}},
+		{
+			name:     "Outside parent",
+			cidr:     "172.16.0.0/12",
+			within:   []string{"10.0.0.0/8"},
+			expected: "CIDR 172.16.0.0/12 is not within 10.0.0.0/8",
+		},
+		{
+			name:     "Larger than parent",
+			cidr:     "10.0.0.0/7",
+			within:   []string{"10.0.0.0/8"},
+			expected: "CIDR 10.0.0.0/7 is not within 10.0.0.0/8",
+		},
+		{
+			name:     "Other IP version",
+			cidr:     "fd00::/8",
+			within:   []string{"10.0.0.0/8", "192.168.0.0/16"},
+			expected: "CIDR fd00::/8 is not within 10.0.0.0/8, 192.168.0.0/16",
+		},
+		{name: "Invalid parent", cidr: "10.1.0.0/16", within: []string{"10.0.0.0"}, expected: "invalid parent CIDR: 10.0.0.0"},
+		{name: "Integer input", cidr: 8, expected: "CIDR must be a string"},
+		{name: "Missing prefix length", cidr: "10.0.0.0", expected: "invalid CIDR: 10.0.0.0"},
+		{name: "Prefix length too long", cidr: "10.0.0.0/33", expected: "invalid CIDR: 10.0.0.0/33"},
+		{name: "Host bits set", cidr: "10.1.2.3/8", expected: "CIDR has host bits set, expected 10.0.0.0/8"},
+		{name: "IPv6 host bits set", cidr: "2001:db8::1/32", expected: "CIDR has host bits set, expected 2001:db8::/32"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCIDR(tt.cidr, tt.within...)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateCIDR() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateCIDR() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateCIDR() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
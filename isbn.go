@@ -0,0 +1,80 @@
+// Package veritas provides ISBN validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ISBN is a validated International Standard Book Number in both formats.
+type ISBN struct {
+	// ISBN10 is empty for ISBN-13s with the 979 prefix, which have no ISBN-10.
+	ISBN10 string
+	ISBN13 string
+}
+
+// ValidateISBN validates an ISBN-10 or ISBN-13.
+func ValidateISBN(isbn interface{}) error {
+	_, err := ParseISBN(isbn)
+	return err
+}
+
+// ParseISBN validates an ISBN-10 or ISBN-13 and returns it converted to both
+// formats. An ISBN-10 is converted by prefixing it with 978 and recalculating
+// the check digit.
+func ParseISBN(isbn interface{}) (ISBN, error) {
+	isbnStr, ok := isbn.(string)
+	if !ok {
+		return ISBN{}, fmt.Errorf("ISBN must be a string")
+	}
+
+	// Clean the ISBN string (remove hyphens and spaces and use uppercase)
+	isbnStr = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(isbnStr)))
+
+	switch len(isbnStr) {
+	case 10:
+		if onlyDigits(isbnStr[:9]) != isbnStr[:9] {
+			return ISBN{}, fmt.Errorf("invalid ISBN-10 format")
+		}
+		if isbnStr[9] != isbn10CheckDigit(isbnStr[:9]) {
+			return ISBN{}, fmt.Errorf("invalid ISBN-10 check digit")
+		}
+		isbn13 := "978" + isbnStr[:9]
+		return ISBN{ISBN10: isbnStr, ISBN13: isbn13 + string(gtinCheckDigit(isbn13))}, nil
+	case 13:
+		if onlyDigits(isbnStr) != isbnStr {
+			return ISBN{}, fmt.Errorf("invalid ISBN-13 format")
+		}
+		if !strings.HasPrefix(isbnStr, "978") && !strings.HasPrefix(isbnStr, "979") {
+			return ISBN{}, fmt.Errorf("ISBN-13 must start with 978 or 979")
+		}
+		if isbnStr[12] != gtinCheckDigit(isbnStr[:12]) {
+			return ISBN{}, fmt.Errorf("invalid ISBN-13 check digit")
+		}
+		parsed := ISBN{ISBN13: isbnStr}
+		if strings.HasPrefix(isbnStr, "978") {
+			parsed.ISBN10 = isbnStr[3:12] + string(isbn10CheckDigit(isbnStr[3:12]))
+		}
+		return parsed, nil
+	default:
+		return ISBN{}, fmt.Errorf("ISBN must have 10 or 13 characters")
+	}
+}
+
+// isbn10CheckDigit calculates the modulo 11 check digit of the first nine
+// digits of an ISBN-10, weighted 10 to 2. A check digit of 10 is written X.
+func isbn10CheckDigit(digits string) byte {
+	return mod11CheckCharacter(weightedSum(digits, []int{10, 9, 8, 7, 6, 5, 4, 3, 2}))
+}
+
+// mod11CheckCharacter returns the modulo 11 check character for a weighted
+// sum, as used by ISBN-10 and ISSN: 11 minus the remainder, where 11 becomes
+// 0 and 10 becomes X.
+func mod11CheckCharacter(sum int) byte {
+	switch digit := (11 - sum%11) % 11; digit {
+	case 10:
+		return 'X'
+	default:
+		return byte('0' + digit)
+	}
+}
@@ -0,0 +1,69 @@
+// Package veritas provides comprehensive unit tests for ISBN validation functions.
+package veritas
+
+import "testing"
+
+// TestParseISBN_ValidCases tests conversion of valid ISBNs between ISBN-10 and ISBN-13
+func TestParseISBN_ValidCases(t *testing.T) {
+	tests := []struct {
+		name           string
+		isbn           string
+		expectedISBN10 string
+		expectedISBN13 string
+	}{
+		{name: "ISBN-10", isbn: "0306406152", expectedISBN10: "0306406152", expectedISBN13: "9780306406157"},
+		{name: "Formatted ISBN-10", isbn: "0-306-40615-2", expectedISBN10: "0306406152", expectedISBN13: "9780306406157"},
+		{name: "ISBN-10 with X check digit", isbn: "0-8044-2957-X", expectedISBN10: "080442957X", expectedISBN13: "9780804429573"},
+		{name: "Lowercase X check digit", isbn: "080442957x", expectedISBN10: "080442957X", expectedISBN13: "9780804429573"},
+		{name: "ISBN-13 with 978 prefix", isbn: "978-0-306-40615-7", expectedISBN10: "0306406152", expectedISBN13: "9780306406157"},
+		{name: "ISBN-13 converted to X check digit", isbn: "9780804429573", expectedISBN10: "080442957X", expectedISBN13: "9780804429573"},
+		{name: "ISBN-13 with 979 prefix has no ISBN-10", isbn: "979-10-90636-07-1", expectedISBN10: "", expectedISBN13: "9791090636071"},
+		{name: "ISBN with spaces", isbn: "978 0 306 40615 7", expectedISBN10: "0306406152", expectedISBN13: "9780306406157"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isbn, err := ParseISBN(tt.isbn)
+			if err != nil {
+				t.Fatalf("ParseISBN() unexpected error: %v", err)
+			}
+			if isbn.ISBN10 != tt.expectedISBN10 {
+				t.Errorf("ParseISBN() ISBN10 = %v, expected %v", isbn.ISBN10, tt.expectedISBN10)
+			}
+			if isbn.ISBN13 != tt.expectedISBN13 {
+				t.Errorf("ParseISBN() ISBN13 = %v, expected %v", isbn.ISBN13, tt.expectedISBN13)
+			}
+		})
+	}
+}
+
+// TestValidateISBN_InvalidCases tests invalid ISBNs
+func TestValidateISBN_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		isbn     interface{}
+		expected string
+	}{
+		{name: "Integer input", isbn: 306406152, expected: "ISBN must be a string"},
+		{name: "Empty string", isbn: "", expected: "ISBN must have 10 or 13 characters"},
+		{name: "Wrong length", isbn: "03064061", expected: "ISBN must have 10 or 13 characters"},
+		{name: "Wrong ISBN-10 check digit", isbn: "0306406153", expected: "invalid ISBN-10 check digit"},
+		{name: "X check digit where digit expected", isbn: "030640615X", expected: "invalid ISBN-10 check digit"},
+		{name: "X outside the check digit", isbn: "03064X6152", expected: "invalid ISBN-10 format"},
+		{name: "Letters in ISBN-13", isbn: "978030640615X", expected: "invalid ISBN-13 format"},
+		{name: "ISBN-13 with other prefix", isbn: "7891000315507", expected: "ISBN-13 must start with 978 or 979"},
+		{name: "Wrong ISBN-13 check digit", isbn: "9780306406158", expected: "invalid ISBN-13 check digit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateISBN(tt.isbn)
+			if err == nil {
+				t.Fatalf("ValidateISBN() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateISBN() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Package veritas provides ISSN validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ISSN is a validated International Standard Serial Number.
+type ISSN struct {
+	// ISSN is formatted as NNNN-NNNC.
+	ISSN string
+	// GTIN13 is the EAN-13 barcode of the serial, with the 977 prefix and
+	// price/variant code 00.
+	GTIN13 string
+}
+
+// ValidateISSN validates an International Standard Serial Number.
+func ValidateISSN(issn interface{}) error {
+	_, err := ParseISSN(issn)
+	return err
+}
+
+// ParseISSN validates an ISSN and returns it formatted and as a GTIN-13.
+func ParseISSN(issn interface{}) (ISSN, error) {
+	issnStr, ok := issn.(string)
+	if !ok {
+		return ISSN{}, fmt.Errorf("ISSN must be a string")
+	}
+
+	// Clean the ISSN string (remove hyphens and spaces and use uppercase)
+	issnStr = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(issnStr)))
+
+	// Check if ISSN has exactly 8 characters
+	if len(issnStr) != 8 {
+		return ISSN{}, fmt.Errorf("ISSN must have exactly 8 characters")
+	}
+	if onlyDigits(issnStr[:7]) != issnStr[:7] {
+		return ISSN{}, fmt.Errorf("invalid ISSN format")
+	}
+
+	// Compare with the modulo 11 check digit, digits weighted 8 to 2
+	if issnStr[7] != mod11CheckCharacter(weightedSum(issnStr[:7], []int{8, 7, 6, 5, 4, 3, 2})) {
+		return ISSN{}, fmt.Errorf("invalid ISSN check digit")
+	}
+
+	gtin := "977" + issnStr[:7] + "00"
+	return ISSN{
+		ISSN:   issnStr[:4] + "-" + issnStr[4:],
+		GTIN13: gtin + string(gtinCheckDigit(gtin)),
+	}, nil
+}
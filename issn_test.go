@@ -0,0 +1,65 @@
+// Package veritas provides comprehensive unit tests for ISSN validation functions.
+package veritas
+
+import "testing"
+
+// TestParseISSN_ValidCases tests formatting and GTIN-13 conversion of valid ISSNs
+func TestParseISSN_ValidCases(t *testing.T) {
+	tests := []struct {
+		name           string
+		issn           string
+		expectedISSN   string
+		expectedGTIN13 string
+	}{
+		{name: "Formatted ISSN", issn: "0317-8471", expectedISSN: "0317-8471", expectedGTIN13: "9770317847001"},
+		{name: "Unformatted ISSN", issn: "03785955", expectedISSN: "0378-5955", expectedGTIN13: "9770378595002"},
+		{name: "ISSN with X check digit", issn: "2434-561X", expectedISSN: "2434-561X", expectedGTIN13: "9772434561006"},
+		{name: "Lowercase X check digit", issn: "2434-561x", expectedISSN: "2434-561X", expectedGTIN13: "9772434561006"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issn, err := ParseISSN(tt.issn)
+			if err != nil {
+				t.Fatalf("ParseISSN() unexpected error: %v", err)
+			}
+			if issn.ISSN != tt.expectedISSN {
+				t.Errorf("ParseISSN() ISSN = %v, expected %v", issn.ISSN, tt.expectedISSN)
+			}
+			if issn.GTIN13 != tt.expectedGTIN13 {
+				t.Errorf("ParseISSN() GTIN13 = %v, expected %v", issn.GTIN13, tt.expectedGTIN13)
+			}
+			if err := ValidateGTIN(issn.GTIN13); err != nil {
+				t.Errorf("ValidateGTIN(%v) unexpected error: %v", issn.GTIN13, err)
+			}
+		})
+	}
+}
+
+// TestValidateISSN_InvalidCases tests invalid ISSNs
+func TestValidateISSN_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		issn     interface{}
+		expected string
+	}{
+		{name: "Integer input", issn: 3178471, expected: "ISSN must be a string"},
+		{name: "Empty string", issn: "", expected: "ISSN must have exactly 8 characters"},
+		{name: "Too short", issn: "0317-847", expected: "ISSN must have exactly 8 characters"},
+		{name: "Letters", issn: "03A7-8471", expected: "invalid ISSN format"},
+		{name: "Wrong check digit", issn: "0317-8472", expected: "invalid ISSN check digit"},
+		{name: "Digit instead of X", issn: "2434-5610", expected: "invalid ISSN check digit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateISSN(tt.issn)
+			if err == nil {
+				t.Fatalf("ValidateISSN() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateISSN() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package veritas provides KSUID validation functions.
+package veritas
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// KSUID_EPOCH is the Unix time in seconds that KSUID timestamps count from.
+const KSUID_EPOCH = 1400000000
+
+// ksuidAlphabet is the base62 alphabet used by KSUIDs.
+const ksuidAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// ksuidMax is the largest KSUID, 20 bytes of 0xff.
+const ksuidMax = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+// KSUID is a validated K-Sortable Unique Identifier.
+type KSUID struct {
+	KSUID string
+	// Time is the second creation time, in UTC.
+	Time time.Time
+	// Payload is the 16-byte random payload in hexadecimal.
+	Payload string
+}
+
+// ValidateKSUID validates a KSUID.
+func ValidateKSUID(ksuid interface{}) error {
+	_, err := ParseKSUID(ksuid)
+	return err
+}
+
+// ParseKSUID validates a 27-character base62 KSUID and returns its creation
+// time and payload.
+func ParseKSUID(ksuid interface{}) (KSUID, error) {
+	ksuidStr, ok := ksuid.(string)
+	if !ok {
+		return KSUID{}, fmt.Errorf("KSUID must be a string")
+	}
+	ksuidStr = strings.TrimSpace(ksuidStr)
+
+	// Check if KSUID has exactly 27 characters
+	if len(ksuidStr) != 27 {
+		return KSUID{}, fmt.Errorf("KSUID must have exactly 27 characters")
+	}
+
+	value := new(big.Int)
+	for _, c := range ksuidStr {
+		digit := strings.IndexRune(ksuidAlphabet, c)
+		if digit < 0 {
+			return KSUID{}, fmt.Errorf("invalid KSUID character: %q", c)
+		}
+		value.Mul(value, big.NewInt(62)).Add(value, big.NewInt(int64(digit)))
+	}
+
+	// Base62 strings of the same length sort like their values
+	if ksuidStr > ksuidMax {
+		return KSUID{}, fmt.Errorf("KSUID overflows 20 bytes")
+	}
+
+	b := value.FillBytes(make([]byte, 20))
+	seconds := int64(b[0])<<24 | int64(b[1])<<16 | int64(b[2])<<8 | int64(b[3])
+
+	return KSUID{
+		KSUID:   ksuidStr,
+		Time:    time.Unix(KSUID_EPOCH+seconds, 0).UTC(),
+		Payload: hex.EncodeToString(b[4:]),
+	}, nil
+}
@@ -0,0 +1,82 @@
+// Package veritas provides comprehensive unit tests for KSUID validation functions.
+package veritas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseKSUID_ValidCases tests timestamp and payload extraction of valid KSUIDs
+func TestParseKSUID_ValidCases(t *testing.T) {
+	tests := []struct {
+		name            string
+		ksuid           string
+		expectedTime    time.Time
+		expectedPayload string
+	}{
+		{
+			name:            "Reference example",
+			ksuid:           "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+			expectedTime:    time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC),
+			expectedPayload: "b5a1cd34b5f99d1154fb6853345c9735",
+		},
+		{
+			name:            "Nil KSUID",
+			ksuid:           "000000000000000000000000000",
+			expectedTime:    time.Unix(KSUID_EPOCH, 0).UTC(),
+			expectedPayload: "00000000000000000000000000000000",
+		},
+		{
+			name:            "Max KSUID",
+			ksuid:           "aWgEPTl1tmebfsQzFP4bxwgy80V",
+			expectedTime:    time.Unix(KSUID_EPOCH+1<<32-1, 0).UTC(),
+			expectedPayload: "ffffffffffffffffffffffffffffffff",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ksuid, err := ParseKSUID(tt.ksuid)
+			if err != nil {
+				t.Fatalf("ParseKSUID() unexpected error: %v", err)
+			}
+			if ksuid.KSUID != tt.ksuid {
+				t.Errorf("ParseKSUID() KSUID = %v, expected %v", ksuid.KSUID, tt.ksuid)
+			}
+			if !ksuid.Time.Equal(tt.expectedTime) {
+				t.Errorf("ParseKSUID() Time = %v, expected %v", ksuid.Time, tt.expectedTime)
+			}
+			if ksuid.Payload != tt.expectedPayload {
+				t.Errorf("ParseKSUID() Payload = %v, expected %v", ksuid.Payload, tt.expectedPayload)
+			}
+		})
+	}
+}
+
+// TestValidateKSUID_InvalidCases tests invalid KSUIDs
+func TestValidateKSUID_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		ksuid    interface{}
+		expected string
+	}{
+		{name: "Integer input", ksuid: 1, expected: "KSUID must be a string"},
+		{name: "Empty string", ksuid: "", expected: "KSUID must have exactly 27 characters"},
+		{name: "Too long", ksuid: "0ujtsYcgvSTl8PAuAdqWYSMnLOvX", expected: "KSUID must have exactly 27 characters"},
+		{name: "Hyphen", ksuid: "0ujtsYcgvSTl8PAuAdqWYSMnLO-", expected: `invalid KSUID character: '-'`},
+		{name: "Overflow", ksuid: "aWgEPTl1tmebfsQzFP4bxwgy80W", expected: "KSUID overflows 20 bytes"},
+		{name: "Overflow with lowercase", ksuid: "zzzzzzzzzzzzzzzzzzzzzzzzzzz", expected: "KSUID overflows 20 bytes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateKSUID(tt.ksuid)
+			if err == nil {
+				t.Fatalf("ValidateKSUID() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateKSUID() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Package veritas provides MAC address validation functions.
+package veritas
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// MAC is a validated EUI-48 or EUI-64 MAC address.
+type MAC struct {
+	// MAC is the address in lowercase, colon-separated form.
+	MAC string
+	// Format is EUI-48 or EUI-64.
+	Format string
+	// Multicast reports whether the group bit of the first octet is set.
+	Multicast bool
+	// Local reports whether the address is locally administered rather than
+	// assigned by the manufacturer.
+	Local bool
+}
+
+// ValidateMAC validates an EUI-48 or EUI-64 MAC address.
+func ValidateMAC(mac interface{}) error {
+	_, err := ParseMAC(mac)
+	return err
+}
+
+// ParseMAC validates a MAC address written with colons (00:1a:2b:3c:4d:5e),
+// hyphens (00-1A-2B-3C-4D-5E), dots every four digits (001a.2b3c.4d5e) or no
+// separators, and returns it in canonical form.
+func ParseMAC(mac interface{}) (MAC, error) {
+	macStr, ok := mac.(string)
+	if !ok {
+		return MAC{}, fmt.Errorf("MAC address must be a string")
+	}
+	macStr = strings.TrimSpace(macStr)
+
+	// Split the MAC string into groups of one separator
+	groups, groupLength := []string{macStr}, len(macStr)
+	for _, separator := range []string{":", "-", "."} {
+		if strings.Contains(macStr, separator) {
+			groups, groupLength = strings.Split(macStr, separator), 2
+			if separator == "." {
+				groupLength = 4
+			}
+			break
+		}
+	}
+	for _, group := range groups {
+		if len(group) != groupLength {
+			return MAC{}, fmt.Errorf("invalid MAC address format")
+		}
+	}
+
+	octets, err := hex.DecodeString(strings.Join(groups, ""))
+	if err != nil {
+		return MAC{}, fmt.Errorf("invalid MAC address format")
+	}
+	if len(octets) != 6 && len(octets) != 8 {
+		return MAC{}, fmt.Errorf("MAC address must have 6 or 8 octets")
+	}
+
+	return MAC{
+		MAC:       net.HardwareAddr(octets).String(),
+		Format:    fmt.Sprintf("EUI-%d", len(octets)*8),
+		Multicast: octets[0]&0x01 != 0,
+		Local:     octets[0]&0x02 != 0,
+	}, nil
+}
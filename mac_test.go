@@ -0,0 +1,79 @@
+// Package veritas provides comprehensive unit tests for MAC address validation functions.
+package veritas
+
+import "testing"
+
+// TestParseMAC_ValidCases tests separators, formats and flags of valid MAC addresses
+func TestParseMAC_ValidCases(t *testing.T) {
+	tests := []struct {
+		name              string
+		mac               string
+		expectedMAC       string
+		expectedFormat    string
+		expectedMulticast bool
+		expectedLocal     bool
+	}{
+		{name: "Colons", mac: "00:1a:2b:3c:4d:5e", expectedMAC: "00:1a:2b:3c:4d:5e", expectedFormat: "EUI-48"},
+		{name: "Uppercase hyphens", mac: "00-1A-2B-3C-4D-5E", expectedMAC: "00:1a:2b:3c:4d:5e", expectedFormat: "EUI-48"},
+		{name: "Dots", mac: "001a.2b3c.4d5e", expectedMAC: "00:1a:2b:3c:4d:5e", expectedFormat: "EUI-48"},
+		{name: "No separators", mac: "001A2B3C4D5E", expectedMAC: "00:1a:2b:3c:4d:5e", expectedFormat: "EUI-48"},
+		{name: "EUI-64 with colons", mac: "00:1a:2b:ff:fe:3c:4d:5e", expectedMAC: "00:1a:2b:ff:fe:3c:4d:5e", expectedFormat: "EUI-64"},
+		{name: "EUI-64 with dots", mac: "001a.2bff.fe3c.4d5e", expectedMAC: "00:1a:2b:ff:fe:3c:4d:5e", expectedFormat: "EUI-64"},
+		{name: "Broadcast", mac: "ff:ff:ff:ff:ff:ff", expectedMAC: "ff:ff:ff:ff:ff:ff", expectedFormat: "EUI-48", expectedMulticast: true, expectedLocal: true},
+		{name: "Multicast", mac: "01:00:5e:00:00:fb", expectedMAC: "01:00:5e:00:00:fb", expectedFormat: "EUI-48", expectedMulticast: true},
+		{name: "Locally administered", mac: "02:42:ac:11:00:02", expectedMAC: "02:42:ac:11:00:02", expectedFormat: "EUI-48", expectedLocal: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mac, err := ParseMAC(tt.mac)
+			if err != nil {
+				t.Fatalf("ParseMAC() unexpected error: %v", err)
+			}
+			if mac.MAC != tt.expectedMAC {
+				t.Errorf("ParseMAC() MAC = %v, expected %v", mac.MAC, tt.expectedMAC)
+			}
+			if mac.Format != tt.expectedFormat {
+				t.Errorf("ParseMAC() Format = %v, expected %v", mac.Format, tt.expectedFormat)
+			}
+			if mac.Multicast != tt.expectedMulticast {
+				t.Errorf("ParseMAC() Multicast = %v, expected %v", mac.Multicast, tt.expectedMulticast)
+			}
+			if mac.Local != tt.expectedLocal {
+				t.Errorf("ParseMAC() Local = %v, expected %v", mac.Local, tt.expectedLocal)
+			}
+		})
+	}
+}
+
+// TestValidateMAC_InvalidCases tests invalid MAC addresses
+func TestValidateMAC_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		mac      interface{}
+		expected string
+	}{
+		{name: "Integer input", mac: 112233, expected: "MAC address must be a string"},
+		{name: "Empty string", mac: "", expected: "MAC address must have 6 or 8 octets"},
+		{name: "Mixed separators", mac: "00:1a-2b:3c:4d:5e", expected: "invalid MAC address format"},
+		{name: "Single-digit group", mac: "0:1a:2b:3c:4d:5e", expected: "invalid MAC address format"},
+		{name: "Non-hex digit", mac: "00:1a:2b:3c:4d:5g", expected: "invalid MAC address format"},
+		{name: "Odd number of digits", mac: "001a2b3c4d5", expected: "invalid MAC address format"},
+		{name: "Dots every two digits", mac: "00.1a.2b.3c.4d.5e", expected: "invalid MAC address format"},
+		{name: "Five octets", mac: "00:1a:2b:3c:4d", expected: "MAC address must have 6 or 8 octets"},
+		{name: "Seven octets", mac: "00:1a:2b:3c:4d:5e:6f", expected: "MAC address must have 6 or 8 octets"},
+		{name: "InfiniBand length", mac: "00000000000000000000000000000000000000000000", expected: "MAC address must have 6 or 8 octets"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMAC(tt.mac)
+			if err == nil {
+				t.Fatalf("ValidateMAC() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateMAC() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
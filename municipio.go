@@ -0,0 +1,156 @@
+// Package veritas provides IBGE municipality code validation functions.
+package veritas
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrMunicipioNotFound is returned when a well-formed IBGE municipality code
+// or name is missing from the municipality directory.
+var ErrMunicipioNotFound = errors.New("IBGE municipality not found")
+
+// Municipio is a Brazilian municipality identified by its 7-digit IBGE code.
+type Municipio struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	UF   string `json:"uf"`
+}
+
+// ibgeCheckDigitExceptions lists the municipalities created with codes whose
+// last digit does not follow the IBGE check digit rule.
+var ibgeCheckDigitExceptions = map[string]bool{
+	"2201919": true, "2201988": true, "2202251": true, "2611533": true, "3117836": true,
+	"3152131": true, "4305871": true, "5203939": true, "5203962": true,
+}
+
+//go:generate go run ./internal/gentables municipios
+
+// defaultMunicipios is the directory built from the embedded IBGE
+// municipality table.
+var defaultMunicipios = mustLoadEmbeddedTable("tables/municipios.json", decodeMunicipioDirectory)
+
+// MunicipioDirectory looks up municipalities by IBGE code or by name and UF.
+type MunicipioDirectory struct {
+	byCode map[string]Municipio
+	byName map[string]Municipio
+}
+
+// NewMunicipioDirectory creates a MunicipioDirectory holding the given municipalities.
+func NewMunicipioDirectory(municipios []Municipio) *MunicipioDirectory {
+	directory := &MunicipioDirectory{
+		byCode: make(map[string]Municipio, len(municipios)),
+		byName: make(map[string]Municipio, len(municipios)),
+	}
+	for _, municipio := range municipios {
+		municipio.Code = onlyDigits(municipio.Code)
+		municipio.UF = strings.ToUpper(strings.TrimSpace(municipio.UF))
+		directory.byCode[municipio.Code] = municipio
+		directory.byName[municipioNameKey(municipio.Name, municipio.UF)] = municipio
+	}
+	return directory
+}
+
+// LoadMunicipioDirectoryFile creates a MunicipioDirectory from a JSON file
+// holding an array of {"code", "name", "uf"} objects, the format of the
+// embedded table, to validate against a newer table than the embedded one.
+func LoadMunicipioDirectoryFile(path string) (*MunicipioDirectory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading municipality file: %w", err)
+	}
+	return decodeMunicipioDirectory(data)
+}
+
+// ValidateIBGEMunicipio validates an IBGE municipality code against the
+// embedded municipality table.
+func ValidateIBGEMunicipio(code interface{}) error {
+	_, err := ParseIBGEMunicipio(code)
+	return err
+}
+
+// ParseIBGEMunicipio validates an IBGE municipality code and returns the
+// municipality from the embedded table.
+func ParseIBGEMunicipio(code interface{}) (Municipio, error) {
+	return defaultMunicipios.Lookup(code)
+}
+
+// FindIBGEMunicipio finds a municipality of the embedded table by name and
+// UF, ignoring case and accents.
+func FindIBGEMunicipio(name, uf string) (Municipio, error) {
+	return defaultMunicipios.Find(name, uf)
+}
+
+// Lookup validates an IBGE municipality code, including its UF prefix and
+// check digit, and returns the municipality it identifies.
+func (d *MunicipioDirectory) Lookup(code interface{}) (Municipio, error) {
+	codeStr, err := cleanIBGEMunicipioCode(code)
+	if err != nil {
+		return Municipio{}, err
+	}
+
+	municipio, ok := d.byCode[codeStr]
+	if !ok {
+		return Municipio{}, ErrMunicipioNotFound
+	}
+	return municipio, nil
+}
+
+// Find returns the municipality with the given name in the given UF, ignoring
+// case and accents.
+func (d *MunicipioDirectory) Find(name, uf string) (Municipio, error) {
+	if _, err := IBGECodeFromUF(uf); err != nil {
+		return Municipio{}, err
+	}
+
+	municipio, ok := d.byName[municipioNameKey(name, uf)]
+	if !ok {
+		return Municipio{}, ErrMunicipioNotFound
+	}
+	return municipio, nil
+}
+
+// municipioNameKey builds the name index key of a municipality.
+func municipioNameKey(name, uf string) string {
+	return foldName(name) + "/" + strings.ToUpper(strings.TrimSpace(uf))
+}
+
+// cleanIBGEMunicipioCode strips formatting from an IBGE municipality code and
+// checks its length, UF prefix and check digit.
+func cleanIBGEMunicipioCode(code interface{}) (string, error) {
+	codeStr, ok := code.(string)
+	if !ok {
+		return "", fmt.Errorf("IBGE municipality code must be a string")
+	}
+
+	// Clean the code string (remove non-numeric characters)
+	codeStr = onlyDigits(codeStr)
+
+	// Check if code has exactly 7 digits
+	if len(codeStr) != 7 {
+		return "", fmt.Errorf("IBGE municipality code must have exactly 7 digits")
+	}
+
+	if _, err := UFFromIBGECode(codeStr[:2]); err != nil {
+		return "", err
+	}
+
+	// Compare with provided check digit
+	if codeStr[6] != mod10CheckDigit(codeStr[:6]) && !ibgeCheckDigitExceptions[codeStr] {
+		return "", fmt.Errorf("invalid IBGE municipality check digit")
+	}
+	return codeStr, nil
+}
+
+// decodeMunicipioDirectory parses the JSON representation of a municipality
+// directory.
+func decodeMunicipioDirectory(data []byte) (*MunicipioDirectory, error) {
+	var municipios []Municipio
+	if err := json.Unmarshal(data, &municipios); err != nil {
+		return nil, fmt.Errorf("decoding municipality file: %w", err)
+	}
+	return NewMunicipioDirectory(municipios), nil
+}
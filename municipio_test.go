@@ -0,0 +1,171 @@
+// Package veritas provides comprehensive unit tests for IBGE municipality code validation functions.
+package veritas
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseIBGEMunicipio_ValidCases tests lookups of valid municipality codes
+func TestParseIBGEMunicipio_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected Municipio
+	}{
+		{
+			name:     "São Paulo",
+			code:     "3550308",
+			expected: Municipio{Code: "3550308", Name: "São Paulo", UF: "SP"},
+		},
+		{
+			name:     "Formatted code",
+			code:     "33.04557",
+			expected: Municipio{Code: "3304557", Name: "Rio de Janeiro", UF: "RJ"},
+		},
+		{
+			name:     "Check digit exception",
+			code:     "2201919",
+			expected: Municipio{Code: "2201919", Name: "Bom Princípio do Piauí", UF: "PI"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			municipio, err := ParseIBGEMunicipio(tt.code)
+			if err != nil {
+				t.Fatalf("ParseIBGEMunicipio() unexpected error: %v", err)
+			}
+			if municipio != tt.expected {
+				t.Errorf("ParseIBGEMunicipio() = %+v, expected %+v", municipio, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateIBGEMunicipio_InvalidCases tests invalid municipality codes
+func TestValidateIBGEMunicipio_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			code:     3550308,
+			expected: "IBGE municipality code must be a string",
+		},
+		{
+			name:     "Six digits",
+			code:     "355030",
+			expected: "IBGE municipality code must have exactly 7 digits",
+		},
+		{
+			name:     "Unknown UF code",
+			code:     "9950308",
+			expected: "invalid IBGE UF code: 99",
+		},
+		{
+			name:     "Wrong check digit",
+			code:     "3550307",
+			expected: "invalid IBGE municipality check digit",
+		},
+		{
+			name:     "Unknown municipality",
+			code:     "3599990",
+			expected: "IBGE municipality not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIBGEMunicipio(tt.code)
+			if err == nil {
+				t.Errorf("ValidateIBGEMunicipio() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateIBGEMunicipio() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestFindIBGEMunicipio tests lookups by name and UF
+func TestFindIBGEMunicipio(t *testing.T) {
+	tests := []struct {
+		name         string
+		municipio    string
+		uf           string
+		expectedCode string
+		expectedErr  string
+	}{
+		{name: "Exact name", municipio: "Florianópolis", uf: "SC", expectedCode: "4205407"},
+		{name: "Without accents and lowercase", municipio: "sao  jose dos campos", uf: "sp", expectedCode: "3549904"},
+		{name: "Wrong UF", municipio: "Curitiba", uf: "SC", expectedErr: "IBGE municipality not found"},
+		{name: "Invalid UF", municipio: "Curitiba", uf: "XX", expectedErr: "invalid UF: XX"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			municipio, err := FindIBGEMunicipio(tt.municipio, tt.uf)
+			if tt.expectedErr != "" {
+				if err == nil || err.Error() != tt.expectedErr {
+					t.Errorf("FindIBGEMunicipio() error = %v, expected %v", err, tt.expectedErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindIBGEMunicipio() unexpected error: %v", err)
+			}
+			if municipio.Code != tt.expectedCode {
+				t.Errorf("FindIBGEMunicipio() Code = %v, expected %v", municipio.Code, tt.expectedCode)
+			}
+		})
+	}
+}
+
+// TestIBGEMunicipios_Consistency tests the embedded table against the code rules
+func TestIBGEMunicipios_Consistency(t *testing.T) {
+	if len(defaultMunicipios.byCode) == 0 {
+		t.Fatal("embedded directory is empty")
+	}
+	for _, municipio := range defaultMunicipios.byCode {
+		if uf, err := UFFromIBGECode(municipio.Code[:2]); err != nil || uf != municipio.UF {
+			t.Errorf("%s (%s) has UF %s, code prefix gives %v", municipio.Name, municipio.Code, municipio.UF, uf)
+		}
+		validDigit := municipio.Code[6] == mod10CheckDigit(municipio.Code[:6])
+		if validDigit == ibgeCheckDigitExceptions[municipio.Code] {
+			t.Errorf("%s (%s) check digit validity = %v, exception = %v", municipio.Name, municipio.Code, validDigit, !validDigit)
+		}
+	}
+}
+
+// TestLoadMunicipioDirectoryFile tests loading a directory from a JSON file
+func TestLoadMunicipioDirectoryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "municipios.json")
+	data := `[{"code": "3500105", "name": "Adamantina", "uf": "SP"}]`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	directory, err := LoadMunicipioDirectoryFile(path)
+	if err != nil {
+		t.Fatalf("LoadMunicipioDirectoryFile() unexpected error: %v", err)
+	}
+
+	municipio, err := directory.Lookup("3500105")
+	if err != nil || municipio.Name != "Adamantina" {
+		t.Errorf("Lookup() = %+v, %v, expected Adamantina", municipio, err)
+	}
+	if _, err := directory.Lookup("3550308"); !errors.Is(err, ErrMunicipioNotFound) {
+		t.Errorf("Lookup() error = %v, expected %v", err, ErrMunicipioNotFound)
+	}
+	if _, err := directory.Find("ADAMANTINA", "SP"); err != nil {
+		t.Errorf("Find() error = %v, expected nil", err)
+	}
+
+	if _, err := LoadMunicipioDirectoryFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("LoadMunicipioDirectoryFile() expected error for missing file, got nil")
+	}
+}
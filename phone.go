@@ -88,45 +88,51 @@ func validateLandline(phone string) error {
 	return nil
 }
 
-// isValidDDD validates Brazilian area codes (DDD).
-func isValidDDD(ddd string) bool {
-	// Valid DDDs in Brazil (2 digits, first 1-9, second 1-9)
-	validDDDs := []string{
-		"11", "12", "13", "14", "15", "16", "17", "18", "19", // São Paulo
-		"21", "22", "24", // Rio de Janeiro
-		"27", "28", // Espírito Santo
-		"31", "32", "33", "34", "35", "37", "38", // Minas Gerais
-		"41", "42", "43", "44", "45", "46", // Paraná
-		"47", "48", "49", // Santa Catarina
-		"51", "53", "54", "55", // Rio Grande do Sul
-		"61",       // Distrito Federal
-		"62", "64", // Goiás
-		"63",       // Tocantins
-		"65", "66", // Mato Grosso
-		"67",                         // Mato Grosso do Sul
-		"68",                         // Acre
-		"69",                         // Rondônia
-		"71", "73", "74", "75", "77", // Bahia
-		"79",       // Sergipe
-		"81", "87", // Pernambuco
-		"82",       // Alagoas
-		"83",       // Paraíba
-		"84",       // Rio Grande do Norte
-		"85", "88", // Ceará
-		"86", "89", // Piauí
-		"91", "93", "94", // Pará
-		"92", "97", // Amazonas
-		"95",       // Roraima
-		"96",       // Amapá
-		"98", "99", // Maranhão
-	}
+// dddUFs maps each valid Brazilian area code (DDD) to its UF.
+var dddUFs = map[string]string{
+	"11": "SP", "12": "SP", "13": "SP", "14": "SP", "15": "SP", "16": "SP", "17": "SP", "18": "SP", "19": "SP",
+	"21": "RJ", "22": "RJ", "24": "RJ",
+	"27": "ES", "28": "ES",
+	"31": "MG", "32": "MG", "33": "MG", "34": "MG", "35": "MG", "37": "MG", "38": "MG",
+	"41": "PR", "42": "PR", "43": "PR", "44": "PR", "45": "PR", "46": "PR",
+	"47": "SC", "48": "SC", "49": "SC",
+	"51": "RS", "53": "RS", "54": "RS", "55": "RS",
+	"61": "DF",
+	"62": "GO", "64": "GO",
+	"63": "TO",
+	"65": "MT", "66": "MT",
+	"67": "MS",
+	"68": "AC",
+	"69": "RO",
+	"71": "BA", "73": "BA", "74": "BA", "75": "BA", "77": "BA",
+	"79": "SE",
+	"81": "PE", "87": "PE",
+	"82": "AL",
+	"83": "PB",
+	"84": "RN",
+	"85": "CE", "88": "CE",
+	"86": "PI", "89": "PI",
+	"91": "PA", "93": "PA", "94": "PA",
+	"92": "AM", "97": "AM",
+	"95": "RR",
+	"96": "AP",
+	"98": "MA", "99": "MA",
+}
 
-	for _, validDDD := range validDDDs {
-		if ddd == validDDD {
-			return true
-		}
+// PhoneUF validates a Brazilian phone number and returns the UF of its area code (DDD).
+func PhoneUF(phone interface{}) (string, error) {
+	if err := ValidatePhone(phone); err != nil {
+		return "", err
 	}
-	return false
+
+	phoneStr := strings.TrimPrefix(cleanPhone(phone.(string)), "+55")
+	return dddUFs[phoneStr[:2]], nil
+}
+
+// isValidDDD validates Brazilian area codes (DDD).
+func isValidDDD(ddd string) bool {
+	_, ok := dddUFs[ddd]
+	return ok
 }
 
 // isValidPhoneDigits validates phone number digits.
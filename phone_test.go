@@ -336,3 +336,56 @@ func TestValidatePhone_TypeValidation(t *testing.T) {
 		})
 	}
 }
+
+// TestPhoneUF tests UF detection from the area code
+func TestPhoneUF(t *testing.T) {
+	tests := []struct {
+		name     string
+		phone    interface{}
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "Mobile with country code",
+			phone:    "+55 41 99504-8710",
+			expected: "PR",
+		},
+		{
+			name:     "Mobile without country code",
+			phone:    "(11) 98765-4321",
+			expected: "SP",
+		},
+		{
+			name:     "Area code 55 without country code",
+			phone:    "(55) 99999-8888",
+			expected: "RS",
+		},
+		{
+			name:    "Invalid area code",
+			phone:   "+55 20 99999-8888",
+			wantErr: true,
+		},
+		{
+			name:    "Non-string input",
+			phone:   5541995048710,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uf, err := PhoneUF(tt.phone)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("PhoneUF() expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("PhoneUF() unexpected error: %v", err)
+			} else if uf != tt.expected {
+				t.Errorf("PhoneUF() = %v, expected %v", uf, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Package veritas provides Brazilian PIS/PASEP validation functions.
+package veritas
+
+import (
+	"fmt"
+)
+
+// ValidatePIS validates a Brazilian PIS/PASEP number. NIT and NIS numbers share
+// the same format and check digit and are accepted as well.
+func ValidatePIS(pis interface{}) error {
+	_, err := NormalizePIS(pis)
+	return err
+}
+
+// NormalizePIS validates a PIS/PASEP number and returns its 11 digits without formatting.
+func NormalizePIS(pis interface{}) (string, error) {
+	pisStr, ok := pis.(string)
+	if !ok {
+		return "", fmt.Errorf("PIS must be a string")
+	}
+
+	// Clean the PIS string (remove non-numeric characters)
+	pisStr = onlyDigits(pisStr)
+
+	// Check if PIS has exactly 11 digits
+	if len(pisStr) != 11 {
+		return "", fmt.Errorf("PIS must have exactly 11 digits")
+	}
+
+	// Check for invalid sequences (all same digits)
+	if isRepeatedDigits(pisStr) {
+		return "", fmt.Errorf("PIS cannot be a sequence of identical digits")
+	}
+
+	// Compare with provided check digit
+	if pisStr[10] != pisCheckDigit(pisStr[:10]) {
+		return "", fmt.Errorf("invalid PIS check digit")
+	}
+
+	return pisStr, nil
+}
+
+// FormatPIS validates a PIS/PASEP number and formats it as 000.00000.00-0.
+func FormatPIS(pis interface{}) (string, error) {
+	pisStr, err := NormalizePIS(pis)
+	if err != nil {
+		return "", err
+	}
+	return pisStr[:3] + "." + pisStr[3:8] + "." + pisStr[8:10] + "-" + pisStr[10:], nil
+}
+
+// GeneratePIS returns a random valid PIS/PASEP number, useful as a test fixture.
+func GeneratePIS() string {
+	for {
+		base := randomDigits(10)
+		if !isRepeatedDigits(base) {
+			return base + string(pisCheckDigit(base))
+		}
+	}
+}
+
+// pisCheckDigit calculates the PIS/PASEP check digit for a 10-digit base.
+func pisCheckDigit(base string) byte {
+	checkDigit := 11 - weightedSum(base, []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	if checkDigit >= 10 {
+		checkDigit = 0
+	}
+	return byte('0' + checkDigit)
+}
@@ -0,0 +1,124 @@
+// Package veritas provides comprehensive unit tests for PIS/PASEP validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestValidatePIS_ValidCases tests valid PIS/PASEP numbers with various formats
+func TestValidatePIS_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		pis      string
+		expected error
+	}{
+		{
+			name:     "Valid PIS without formatting",
+			pis:      "17033259504",
+			expected: nil,
+		},
+		{
+			name:     "Valid PIS with dots and hyphen",
+			pis:      "170.33259.50-4",
+			expected: nil,
+		},
+		{
+			name:     "Valid PIS with spaces",
+			pis:      "123 45678 91 9",
+			expected: nil,
+		},
+		{
+			name:     "Valid PIS with check digit from overflow",
+			pis:      "120.45843.23-0",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePIS(tt.pis)
+			if err != tt.expected {
+				t.Errorf("ValidatePIS() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidatePIS_InvalidCases tests invalid PIS/PASEP numbers
+func TestValidatePIS_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		pis      interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			pis:      17033259504,
+			expected: "PIS must be a string",
+		},
+		{
+			name:     "Too short",
+			pis:      "1703325950",
+			expected: "PIS must have exactly 11 digits",
+		},
+		{
+			name:     "Too long",
+			pis:      "170332595040",
+			expected: "PIS must have exactly 11 digits",
+		},
+		{
+			name:     "All zeros",
+			pis:      "000.00000.00-0",
+			expected: "PIS cannot be a sequence of identical digits",
+		},
+		{
+			name:     "Invalid check digit",
+			pis:      "170.33259.50-5",
+			expected: "invalid PIS check digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePIS(tt.pis)
+			if err == nil {
+				t.Errorf("ValidatePIS() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidatePIS() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestFormatPIS tests PIS/PASEP normalization and formatting
+func TestFormatPIS(t *testing.T) {
+	normalized, err := NormalizePIS(" 170.33259.50-4 ")
+	if err != nil {
+		t.Fatalf("NormalizePIS() unexpected error: %v", err)
+	}
+	if normalized != "17033259504" {
+		t.Errorf("NormalizePIS() = %v, expected %v", normalized, "17033259504")
+	}
+
+	formatted, err := FormatPIS("17033259504")
+	if err != nil {
+		t.Fatalf("FormatPIS() unexpected error: %v", err)
+	}
+	if formatted != "170.33259.50-4" {
+		t.Errorf("FormatPIS() = %v, expected %v", formatted, "170.33259.50-4")
+	}
+
+	if _, err := FormatPIS("17033259505"); err == nil {
+		t.Errorf("FormatPIS() expected error, got nil")
+	}
+}
+
+// TestGeneratePIS tests that generated PIS/PASEP numbers are valid
+func TestGeneratePIS(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		pis := GeneratePIS()
+		if err := ValidatePIS(pis); err != nil {
+			t.Errorf("GeneratePIS() = %v, ValidatePIS() error = %v", pis, err)
+		}
+	}
+}
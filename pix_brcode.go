@@ -0,0 +1,308 @@
+// Package veritas provides PIX BR Code validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PIX_GUI is the globally unique identifier of the PIX merchant account template.
+	PIX_GUI = "br.gov.bcb.pix"
+	// PIX_MERCHANT_NAME_MAX_LENGTH is the longest merchant name allowed in field 59.
+	PIX_MERCHANT_NAME_MAX_LENGTH = 25
+	// PIX_MERCHANT_CITY_MAX_LENGTH is the longest merchant city allowed in field 60.
+	PIX_MERCHANT_CITY_MAX_LENGTH = 15
+	// PIX_TXID_MAX_LENGTH is the longest txid allowed in a static BR Code.
+	PIX_TXID_MAX_LENGTH = 25
+)
+
+var (
+	pixAmountFormat     = regexp.MustCompile(`^[0-9]{1,10}(\.[0-9]{1,2})?$`)
+	pixTxIDFormat       = regexp.MustCompile(`^(\*\*\*|[0-9A-Za-z]+)$`)
+	pixMCCFormat        = regexp.MustCompile(`^[0-9]{4}$`)
+	pixPostalCodeFormat = regexp.MustCompile(`^[0-9]{8}$`)
+)
+
+// PixBRCode is a parsed PIX BR Code, the EMV payload behind PIX QR codes and
+// "PIX copia e cola" strings.
+type PixBRCode struct {
+	// Dynamic reports whether the code points to a payload URL (field 01 = 12)
+	// instead of carrying a key.
+	Dynamic bool
+	// Key is the normalized PIX key; empty for dynamic codes.
+	Key         string
+	Description string
+	// URL is the payload location of a dynamic code, without the scheme.
+	URL                  string
+	MerchantCategoryCode string
+	// AmountCents is the amount in cents; zero means the payer informs it.
+	AmountCents  int64
+	MerchantName string
+	MerchantCity string
+	PostalCode   string
+	// TxID is the transaction identifier; "***" means none.
+	TxID string
+}
+
+// emvField is a single ID-length-value element of an EMV payload.
+type emvField struct {
+	ID    string
+	Value string
+}
+
+// ValidatePixBRCode validates a PIX BR Code, including its CRC.
+func ValidatePixBRCode(code interface{}) error {
+	_, err := ParsePixBRCode(code)
+	return err
+}
+
+// ParsePixBRCode validates a PIX BR Code and decodes its fields.
+func ParsePixBRCode(code interface{}) (PixBRCode, error) {
+	codeStr, ok := code.(string)
+	if !ok {
+		return PixBRCode{}, fmt.Errorf("BR Code must be a string")
+	}
+
+	codeStr = strings.TrimSpace(codeStr)
+	fields, err := parseEMV(codeStr)
+	if err != nil {
+		return PixBRCode{}, err
+	}
+
+	// The payload format indicator must come first and the CRC last
+	if len(fields) < 2 || fields[0].ID != "00" || fields[0].Value != "01" {
+		return PixBRCode{}, fmt.Errorf("BR Code must start with payload format indicator 01")
+	}
+	last := fields[len(fields)-1]
+	if last.ID != "63" || len(last.Value) != 4 {
+		return PixBRCode{}, fmt.Errorf("BR Code must end with a CRC field")
+	}
+	if !strings.EqualFold(last.Value, crc16CCITT(codeStr[:len(codeStr)-4])) {
+		return PixBRCode{}, fmt.Errorf("invalid BR Code CRC")
+	}
+
+	var brCode PixBRCode
+	found := map[string]bool{}
+	for _, field := range fields[1 : len(fields)-1] {
+		found[field.ID] = true
+		switch field.ID {
+		case "01":
+			if field.Value != "11" && field.Value != "12" {
+				return PixBRCode{}, fmt.Errorf("invalid BR Code point of initiation method")
+			}
+			brCode.Dynamic = field.Value == "12"
+		case "26":
+			if err := parsePixMerchantAccount(field.Value, &brCode); err != nil {
+				return PixBRCode{}, err
+			}
+		case "52":
+			if !pixMCCFormat.MatchString(field.Value) {
+				return PixBRCode{}, fmt.Errorf("invalid BR Code merchant category code")
+			}
+			brCode.MerchantCategoryCode = field.Value
+		case "53":
+			if field.Value != "986" {
+				return PixBRCode{}, fmt.Errorf("BR Code currency must be 986 (BRL)")
+			}
+		case "54":
+			amount, err := parsePixAmount(field.Value)
+			if err != nil {
+				return PixBRCode{}, err
+			}
+			brCode.AmountCents = amount
+		case "58":
+			if field.Value != "BR" {
+				return PixBRCode{}, fmt.Errorf("BR Code country code must be BR")
+			}
+		case "59":
+			if len(field.Value) > PIX_MERCHANT_NAME_MAX_LENGTH {
+				return PixBRCode{}, fmt.Errorf("BR Code merchant name must be at most %d characters long", PIX_MERCHANT_NAME_MAX_LENGTH)
+			}
+			brCode.MerchantName = field.Value
+		case "60":
+			if len(field.Value) > PIX_MERCHANT_CITY_MAX_LENGTH {
+				return PixBRCode{}, fmt.Errorf("BR Code merchant city must be at most %d characters long", PIX_MERCHANT_CITY_MAX_LENGTH)
+			}
+			brCode.MerchantCity = field.Value
+		case "61":
+			if !pixPostalCodeFormat.MatchString(field.Value) {
+				return PixBRCode{}, fmt.Errorf("invalid BR Code postal code")
+			}
+			brCode.PostalCode = field.Value
+		case "62":
+			subfields, err := parseEMV(field.Value)
+			if err != nil {
+				return PixBRCode{}, err
+			}
+			for _, subfield := range subfields {
+				if subfield.ID == "05" {
+					brCode.TxID = subfield.Value
+				}
+			}
+		}
+	}
+
+	// Check the mandatory fields
+	for _, id := range []string{"26", "52", "53", "58", "59", "60", "62"} {
+		if !found[id] {
+			return PixBRCode{}, fmt.Errorf("BR Code is missing mandatory field %s", id)
+		}
+	}
+	if brCode.Dynamic && brCode.URL == "" {
+		return PixBRCode{}, fmt.Errorf("dynamic BR Code must have a payload URL")
+	}
+	if !brCode.Dynamic && brCode.Key == "" && brCode.URL == "" {
+		return PixBRCode{}, fmt.Errorf("BR Code must have a PIX key or a payload URL")
+	}
+
+	return brCode, nil
+}
+
+// BuildPixBRCode generates a static PIX BR Code from the key, merchant name
+// and city, and the optional description, merchant category code, amount,
+// postal code and txid of code. The fields are checked as ParsePixBRCode
+// checks them, so the result always parses back to the same values.
+func BuildPixBRCode(code PixBRCode) (string, error) {
+	key, err := ParsePixKey(code.Key)
+	if err != nil {
+		return "", err
+	}
+	if isEmpty(code.MerchantName) || len(code.MerchantName) > PIX_MERCHANT_NAME_MAX_LENGTH {
+		return "", fmt.Errorf("BR Code merchant name must have between 1 and %d characters", PIX_MERCHANT_NAME_MAX_LENGTH)
+	}
+	if isEmpty(code.MerchantCity) || len(code.MerchantCity) > PIX_MERCHANT_CITY_MAX_LENGTH {
+		return "", fmt.Errorf("BR Code merchant city must have between 1 and %d characters", PIX_MERCHANT_CITY_MAX_LENGTH)
+	}
+	if code.AmountCents < 0 {
+		return "", fmt.Errorf("BR Code amount cannot be negative")
+	}
+	if code.AmountCents/100 > 9999999999 {
+		return "", fmt.Errorf("BR Code amount is too large")
+	}
+	if code.MerchantCategoryCode != "" && !pixMCCFormat.MatchString(code.MerchantCategoryCode) {
+		return "", fmt.Errorf("invalid BR Code merchant category code")
+	}
+	if code.PostalCode != "" && !pixPostalCodeFormat.MatchString(code.PostalCode) {
+		return "", fmt.Errorf("invalid BR Code postal code")
+	}
+
+	txID := code.TxID
+	if txID == "" {
+		txID = "***"
+	}
+	if len(txID) > PIX_TXID_MAX_LENGTH || !pixTxIDFormat.MatchString(txID) {
+		return "", fmt.Errorf("BR Code txid must be alphanumeric with at most %d characters", PIX_TXID_MAX_LENGTH)
+	}
+
+	account := emvEncode("00", PIX_GUI) + emvEncode("01", key.Key)
+	if code.Description != "" {
+		account += emvEncode("02", code.Description)
+	}
+	if len(account) > 99 {
+		return "", fmt.Errorf("BR Code merchant account information is too long")
+	}
+
+	mcc := code.MerchantCategoryCode
+	if mcc == "" {
+		mcc = "0000"
+	}
+
+	payload := emvEncode("00", "01") +
+		emvEncode("26", account) +
+		emvEncode("52", mcc) +
+		emvEncode("53", "986")
+	if code.AmountCents > 0 {
+		payload += emvEncode("54", fmt.Sprintf("%d.%02d", code.AmountCents/100, code.AmountCents%100))
+	}
+	payload += emvEncode("58", "BR") +
+		emvEncode("59", code.MerchantName) +
+		emvEncode("60", code.MerchantCity)
+	if code.PostalCode != "" {
+		payload += emvEncode("61", code.PostalCode)
+	}
+	payload += emvEncode("62", emvEncode("05", txID)) + "6304"
+
+	return payload + crc16CCITT(payload), nil
+}
+
+// parsePixMerchantAccount decodes the PIX merchant account template (field 26).
+func parsePixMerchantAccount(value string, brCode *PixBRCode) error {
+	subfields, err := parseEMV(value)
+	if err != nil {
+		return err
+	}
+	if len(subfields) == 0 || subfields[0].ID != "00" || !strings.EqualFold(subfields[0].Value, PIX_GUI) {
+		return fmt.Errorf("BR Code merchant account must have GUI %s", PIX_GUI)
+	}
+
+	for _, subfield := range subfields[1:] {
+		switch subfield.ID {
+		case "01":
+			key, err := ParsePixKey(subfield.Value)
+			if err != nil {
+				return err
+			}
+			brCode.Key = key.Key
+		case "02":
+			brCode.Description = subfield.Value
+		case "25":
+			brCode.URL = subfield.Value
+		}
+	}
+	return nil
+}
+
+// parsePixAmount converts a BR Code amount such as "10.50" to cents.
+func parsePixAmount(amount string) (int64, error) {
+	if !pixAmountFormat.MatchString(amount) {
+		return 0, fmt.Errorf("invalid BR Code amount")
+	}
+
+	whole, fraction, _ := strings.Cut(amount, ".")
+	fraction = (fraction + "00")[:2]
+	cents, _ := strconv.ParseInt(whole+fraction, 10, 64)
+	return cents, nil
+}
+
+// parseEMV splits an EMV payload into its ID-length-value fields.
+func parseEMV(payload string) ([]emvField, error) {
+	var fields []emvField
+	for len(payload) > 0 {
+		if len(payload) < 4 || onlyDigits(payload[:4]) != payload[:4] {
+			return nil, fmt.Errorf("malformed BR Code field")
+		}
+
+		length, _ := strconv.Atoi(payload[2:4])
+		if len(payload) < 4+length {
+			return nil, fmt.Errorf("BR Code field %s is truncated", payload[:2])
+		}
+		fields = append(fields, emvField{ID: payload[:2], Value: payload[4 : 4+length]})
+		payload = payload[4+length:]
+	}
+	return fields, nil
+}
+
+// emvEncode formats a single EMV field.
+func emvEncode(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// crc16CCITT returns the CRC16-CCITT (polynomial 0x1021, initial value
+// 0xFFFF) of data as four uppercase hex digits.
+func crc16CCITT(data string) string {
+	crc := uint16(0xFFFF)
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
@@ -0,0 +1,372 @@
+// Package veritas provides comprehensive unit tests for PIX BR Code validation functions.
+package veritas
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	// testStaticBRCode is the static BR Code example from the BCB manual.
+	testStaticBRCode  = "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+	testDynamicBRCode = "00020101021226760014br.gov.bcb.pix2554pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca2552040000530398654041.505802BR5913Fulano de Tal6008BRASILIA62070503***6304BE08"
+)
+
+// TestParsePixBRCode_ValidCases tests decoding of valid BR Codes
+func TestParsePixBRCode_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected PixBRCode
+	}{
+		{
+			name: "Static code from BCB manual",
+			code: testStaticBRCode,
+			expected: PixBRCode{
+				Key:                  "123e4567-e12b-12d1-a456-426655440000",
+				MerchantCategoryCode: "0000",
+				MerchantName:         "Fulano de Tal",
+				MerchantCity:         "BRASILIA",
+				TxID:                 "***",
+			},
+		},
+		{
+			name: "Lowercase CRC",
+			code: strings.TrimSuffix(testStaticBRCode, "1D3D") + "1d3d",
+			expected: PixBRCode{
+				Key:                  "123e4567-e12b-12d1-a456-426655440000",
+				MerchantCategoryCode: "0000",
+				MerchantName:         "Fulano de Tal",
+				MerchantCity:         "BRASILIA",
+				TxID:                 "***",
+			},
+		},
+		{
+			name: "Dynamic code with amount",
+			code: testDynamicBRCode,
+			expected: PixBRCode{
+				Dynamic:              true,
+				URL:                  "pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca25",
+				MerchantCategoryCode: "0000",
+				AmountCents:          150,
+				MerchantName:         "Fulano de Tal",
+				MerchantCity:         "BRASILIA",
+				TxID:                 "***",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := ParsePixBRCode(tt.code)
+			if err != nil {
+				t.Fatalf("ParsePixBRCode() unexpected error: %v", err)
+			}
+			if code != tt.expected {
+				t.Errorf("ParsePixBRCode() = %+v, expected %+v", code, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidatePixBRCode_InvalidCases tests invalid BR Codes
+func TestValidatePixBRCode_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			code:     123,
+			expected: "BR Code must be a string",
+		},
+		{
+			name:     "Wrong CRC",
+			code:     strings.TrimSuffix(testStaticBRCode, "1D3D") + "1D3E",
+			expected: "invalid BR Code CRC",
+		},
+		{
+			name:     "Tampered merchant name",
+			code:     strings.Replace(testStaticBRCode, "Fulano", "Fulana", 1),
+			expected: "invalid BR Code CRC",
+		},
+		{
+			name:     "Tampered amount",
+			code:     strings.Replace(testDynamicBRCode, "54041.50", "54049.50", 1),
+			expected: "invalid BR Code CRC",
+		},
+		{
+			name:     "Truncated payload",
+			code:     testStaticBRCode[:len(testStaticBRCode)-10],
+			expected: "BR Code field 62 is truncated",
+		},
+		{
+			name:     "Missing CRC",
+			code:     strings.TrimSuffix(testStaticBRCode, "63041D3D"),
+			expected: "BR Code must end with a CRC field",
+		},
+		{
+			name:     "Wrong payload format indicator",
+			code:     "000202" + testStaticBRCode[6:],
+			expected: "BR Code must start with payload format indicator 01",
+		},
+		{
+			name:     "Not an EMV payload",
+			code:     "not a pix code",
+			expected: "malformed BR Code field",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePixBRCode(tt.code)
+			if err == nil {
+				t.Errorf("ValidatePixBRCode() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidatePixBRCode() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidatePixBRCode_FieldErrors tests field rules on payloads with a correct CRC
+func TestValidatePixBRCode_FieldErrors(t *testing.T) {
+	withCRC := func(payload string) string {
+		payload += "6304"
+		return payload + crc16CCITT(payload)
+	}
+	account := emvEncode("26", emvEncode("00", PIX_GUI)+emvEncode("01", "+5541995048710"))
+	trailer := emvEncode("58", "BR") + emvEncode("59", "Fulano de Tal") + emvEncode("60", "BRASILIA") + emvEncode("62", emvEncode("05", "***"))
+
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+	}{
+		{
+			name:     "Other GUI",
+			code:     withCRC("000201" + emvEncode("26", emvEncode("00", "br.com.example")+emvEncode("01", "+5541995048710")) + "52040000" + "5303986" + trailer),
+			expected: "BR Code merchant account must have GUI br.gov.bcb.pix",
+		},
+		{
+			name:     "Invalid PIX key",
+			code:     withCRC("000201" + emvEncode("26", emvEncode("00", PIX_GUI)+emvEncode("01", "111.444.777-36")) + "52040000" + "5303986" + trailer),
+			expected: "invalid CPF check digits",
+		},
+		{
+			name:     "Foreign currency",
+			code:     withCRC("000201" + account + "52040000" + "5303840" + trailer),
+			expected: "BR Code currency must be 986 (BRL)",
+		},
+		{
+			name:     "Amount with three decimals",
+			code:     withCRC("000201" + account + "52040000" + "5303986" + emvEncode("54", "1.505") + trailer),
+			expected: "invalid BR Code amount",
+		},
+		{
+			name:     "Formatted postal code",
+			code:     withCRC("000201" + account + "52040000" + "5303986" + emvEncode("58", "BR") + emvEncode("59", "Fulano de Tal") + emvEncode("60", "BRASILIA") + emvEncode("61", "70000-000") + emvEncode("62", emvEncode("05", "***"))),
+			expected: "invalid BR Code postal code",
+		},
+		{
+			name:     "Missing merchant category code",
+			code:     withCRC("000201" + account + "5303986" + trailer),
+			expected: "BR Code is missing mandatory field 52",
+		},
+		{
+			name:     "Dynamic code without URL",
+			code:     withCRC("000201" + "010212" + account + "52040000" + "5303986" + trailer),
+			expected: "dynamic BR Code must have a payload URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePixBRCode(tt.code)
+			if err == nil {
+				t.Errorf("ValidatePixBRCode() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidatePixBRCode() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestBuildPixBRCode tests generation of static BR Codes
+func TestBuildPixBRCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     PixBRCode
+		expected string
+	}{
+		{
+			name: "BCB manual example",
+			code: PixBRCode{
+				Key:          "123e4567-e12b-12d1-a456-426655440000",
+				MerchantName: "Fulano de Tal",
+				MerchantCity: "BRASILIA",
+			},
+			expected: testStaticBRCode,
+		},
+		{
+			name: "Amount, description and txid with normalized key",
+			code: PixBRCode{
+				Key:          "111.444.777-35",
+				Description:  "Pedido 42",
+				AmountCents:  1050,
+				MerchantName: "Loja Exemplo",
+				MerchantCity: "CURITIBA",
+				TxID:         "PEDIDO42",
+			},
+			expected: "00020126460014br.gov.bcb.pix0111111444777350209Pedido 42520400005303986540510.505802BR5912Loja Exemplo6008CURITIBA62120508PEDIDO4263043E07",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := BuildPixBRCode(tt.code)
+			if err != nil {
+				t.Fatalf("BuildPixBRCode() unexpected error: %v", err)
+			}
+			if code != tt.expected {
+				t.Errorf("BuildPixBRCode() = %v, expected %v", code, tt.expected)
+			}
+			if err := ValidatePixBRCode(code); err != nil {
+				t.Errorf("ValidatePixBRCode() on built code error = %v", err)
+			}
+		})
+	}
+}
+
+// TestBuildPixBRCode_InvalidCases tests rejected BR Code parameters
+func TestBuildPixBRCode_InvalidCases(t *testing.T) {
+	valid := PixBRCode{Key: "user@example.com", MerchantName: "Fulano de Tal", MerchantCity: "BRASILIA"}
+
+	tests := []struct {
+		name     string
+		modify   func(c *PixBRCode)
+		expected string
+	}{
+		{
+			name:     "Invalid key",
+			modify:   func(c *PixBRCode) { c.Key = "+1 202 555 0123" },
+			expected: "PIX phone key must start with +55",
+		},
+		{
+			name:     "Missing merchant name",
+			modify:   func(c *PixBRCode) { c.MerchantName = "" },
+			expected: "BR Code merchant name must have between 1 and 25 characters",
+		},
+		{
+			name:     "Merchant city too long",
+			modify:   func(c *PixBRCode) { c.MerchantCity = "SAO JOSE DOS PINHAIS" },
+			expected: "BR Code merchant city must have between 1 and 15 characters",
+		},
+		{
+			name:     "Negative amount",
+			modify:   func(c *PixBRCode) { c.AmountCents = -1 },
+			expected: "BR Code amount cannot be negative",
+		},
+		{
+			name:     "Txid with symbols",
+			modify:   func(c *PixBRCode) { c.TxID = "pedido-42" },
+			expected: "BR Code txid must be alphanumeric with at most 25 characters",
+		},
+		{
+			name:     "Amount too large",
+			modify:   func(c *PixBRCode) { c.AmountCents = 1000000000000 },
+			expected: "BR Code amount is too large",
+		},
+		{
+			name:     "Merchant category code with 5 digits",
+			modify:   func(c *PixBRCode) { c.MerchantCategoryCode = "12345" },
+			expected: "invalid BR Code merchant category code",
+		},
+		{
+			name:     "Merchant category code with letters",
+			modify:   func(c *PixBRCode) { c.MerchantCategoryCode = "58A2" },
+			expected: "invalid BR Code merchant category code",
+		},
+		{
+			name:     "Formatted postal code",
+			modify:   func(c *PixBRCode) { c.PostalCode = "80010-000" },
+			expected: "invalid BR Code postal code",
+		},
+		{
+			name:     "Postal code too long",
+			modify:   func(c *PixBRCode) { c.PostalCode = strings.Repeat("1", 60) },
+			expected: "invalid BR Code postal code",
+		},
+		{
+			name:     "Description too long",
+			modify:   func(c *PixBRCode) { c.Description = strings.Repeat("x", 60) },
+			expected: "BR Code merchant account information is too long",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := valid
+			tt.modify(&code)
+			_, err := BuildPixBRCode(code)
+			if err == nil {
+				t.Errorf("BuildPixBRCode() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("BuildPixBRCode() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestBuildPixBRCode_RoundTrip tests that each optional field of a built BR
+// Code parses back to the value it was built from
+func TestBuildPixBRCode_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *PixBRCode)
+	}{
+		{name: "Required fields only", modify: func(c *PixBRCode) {}},
+		{name: "Description", modify: func(c *PixBRCode) { c.Description = "Pedido 42" }},
+		{name: "Merchant category code", modify: func(c *PixBRCode) { c.MerchantCategoryCode = "5812" }},
+		{name: "Amount", modify: func(c *PixBRCode) { c.AmountCents = 1050 }},
+		{name: "Largest amount", modify: func(c *PixBRCode) { c.AmountCents = 999999999999 }},
+		{name: "Postal code", modify: func(c *PixBRCode) { c.PostalCode = "80010000" }},
+		{name: "Txid", modify: func(c *PixBRCode) { c.TxID = "PEDIDO42" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := PixBRCode{Key: "user@example.com", MerchantName: "Fulano de Tal", MerchantCity: "BRASILIA"}
+			tt.modify(&code)
+
+			built, err := BuildPixBRCode(code)
+			if err != nil {
+				t.Fatalf("BuildPixBRCode() unexpected error: %v", err)
+			}
+			parsed, err := ParsePixBRCode(built)
+			if err != nil {
+				t.Fatalf("ParsePixBRCode() on built code error = %v", err)
+			}
+
+			// The builder fills in the defaults for omitted fields
+			expected := code
+			if expected.MerchantCategoryCode == "" {
+				expected.MerchantCategoryCode = "0000"
+			}
+			if expected.TxID == "" {
+				expected.TxID = "***"
+			}
+			if parsed != expected {
+				t.Errorf("ParsePixBRCode() = %+v, expected %+v", parsed, expected)
+			}
+		})
+	}
+}
+
+// TestCRC16CCITT tests the BR Code CRC against the standard check value
+func TestCRC16CCITT(t *testing.T) {
+	if crc := crc16CCITT("123456789"); crc != "29B1" {
+		t.Errorf("crc16CCITT() = %v, expected 29B1", crc)
+	}
+}
@@ -0,0 +1,106 @@
+// Package veritas provides PIX key validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PixKeyType identifies the type of a PIX key.
+type PixKeyType string
+
+const (
+	// PIX_KEY_CPF identifies a key made of an individual's CPF.
+	PIX_KEY_CPF PixKeyType = "CPF"
+	// PIX_KEY_CNPJ identifies a key made of a legal entity's CNPJ.
+	PIX_KEY_CNPJ PixKeyType = "CNPJ"
+	// PIX_KEY_PHONE identifies a key made of a phone number in +55 E.164 form.
+	PIX_KEY_PHONE PixKeyType = "PHONE"
+	// PIX_KEY_EMAIL identifies a key made of an email address.
+	PIX_KEY_EMAIL PixKeyType = "EMAIL"
+	// PIX_KEY_EVP identifies a random key (endereço virtual de pagamento).
+	PIX_KEY_EVP PixKeyType = "EVP"
+)
+
+// PIX_EMAIL_MAX_LENGTH is the longest email accepted as a PIX key by DICT.
+const PIX_EMAIL_MAX_LENGTH = 77
+
+var (
+	pixEVPFormat      = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	pixDocumentFormat = regexp.MustCompile(`^[0-9./\-\s]+$`)
+)
+
+// PixKey is a validated PIX key normalized to the form used in DICT lookups.
+type PixKey struct {
+	Type PixKeyType
+	Key  string
+}
+
+// ValidatePixKey validates a PIX key of any type.
+func ValidatePixKey(key interface{}) error {
+	_, err := ParsePixKey(key)
+	return err
+}
+
+// ParsePixKey detects the type of a PIX key, validates it under the DICT
+// rules and returns it normalized: documents as digits only, phones as
+// +55 E.164, emails and random keys in lowercase.
+func ParsePixKey(key interface{}) (PixKey, error) {
+	keyStr, ok := key.(string)
+	if !ok {
+		return PixKey{}, fmt.Errorf("PIX key must be a string")
+	}
+
+	keyStr = strings.TrimSpace(keyStr)
+	switch {
+	case isEmpty(keyStr):
+		return PixKey{}, fmt.Errorf("PIX key cannot be empty")
+	case strings.Contains(keyStr, "@"):
+		return parsePixEmail(keyStr)
+	case strings.HasPrefix(keyStr, "+"):
+		return parsePixPhone(keyStr)
+	case pixEVPFormat.MatchString(strings.ToLower(keyStr)):
+		return PixKey{Type: PIX_KEY_EVP, Key: strings.ToLower(keyStr)}, nil
+	case pixDocumentFormat.MatchString(keyStr):
+		return parsePixDocument(keyStr)
+	default:
+		return PixKey{}, fmt.Errorf("unrecognized PIX key type")
+	}
+}
+
+// parsePixEmail validates an email PIX key.
+func parsePixEmail(key string) (PixKey, error) {
+	key = strings.ToLower(key)
+	if len(key) > PIX_EMAIL_MAX_LENGTH {
+		return PixKey{}, fmt.Errorf("PIX email key must be at most %d characters long", PIX_EMAIL_MAX_LENGTH)
+	}
+	if err := ValidateEmail(key); err != nil {
+		return PixKey{}, err
+	}
+	return PixKey{Type: PIX_KEY_EMAIL, Key: key}, nil
+}
+
+// parsePixPhone validates a phone PIX key, which must carry the +55 country code.
+func parsePixPhone(key string) (PixKey, error) {
+	key = cleanPhone(key)
+	if !strings.HasPrefix(key, "+55") {
+		return PixKey{}, fmt.Errorf("PIX phone key must start with +55")
+	}
+	if err := ValidatePhone(key); err != nil {
+		return PixKey{}, err
+	}
+	return PixKey{Type: PIX_KEY_PHONE, Key: key}, nil
+}
+
+// parsePixDocument validates a CPF or CNPJ PIX key.
+func parsePixDocument(key string) (PixKey, error) {
+	doc, err := ParseDocument(key)
+	if err != nil {
+		return PixKey{}, err
+	}
+	if doc.Kind == DOCUMENT_CPF {
+		return PixKey{Type: PIX_KEY_CPF, Key: doc.Number}, nil
+	}
+	return PixKey{Type: PIX_KEY_CNPJ, Key: doc.Number}, nil
+}
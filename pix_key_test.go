@@ -0,0 +1,153 @@
+// Package veritas provides comprehensive unit tests for PIX key validation functions.
+package veritas
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParsePixKey_ValidCases tests detection and normalization of valid PIX keys
+func TestParsePixKey_ValidCases(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		expectedType PixKeyType
+		expectedKey  string
+	}{
+		{
+			name:         "CPF",
+			key:          "11144477735",
+			expectedType: PIX_KEY_CPF,
+			expectedKey:  "11144477735",
+		},
+		{
+			name:         "Formatted CPF",
+			key:          "111.444.777-35",
+			expectedType: PIX_KEY_CPF,
+			expectedKey:  "11144477735",
+		},
+		{
+			name:         "Formatted CNPJ",
+			key:          "11.222.333/0001-81",
+			expectedType: PIX_KEY_CNPJ,
+			expectedKey:  "11222333000181",
+		},
+		{
+			name:         "Phone in E.164 form",
+			key:          "+5541995048710",
+			expectedType: PIX_KEY_PHONE,
+			expectedKey:  "+5541995048710",
+		},
+		{
+			name:         "Formatted phone",
+			key:          "+55 (41) 99504-8710",
+			expectedType: PIX_KEY_PHONE,
+			expectedKey:  "+5541995048710",
+		},
+		{
+			name:         "Email",
+			key:          "user@example.com",
+			expectedType: PIX_KEY_EMAIL,
+			expectedKey:  "user@example.com",
+		},
+		{
+			name:         "Mixed case email",
+			key:          " User.Name@Example.COM ",
+			expectedType: PIX_KEY_EMAIL,
+			expectedKey:  "user.name@example.com",
+		},
+		{
+			name:         "Random key",
+			key:          "123e4567-e89b-12d3-a456-426614174000",
+			expectedType: PIX_KEY_EVP,
+			expectedKey:  "123e4567-e89b-12d3-a456-426614174000",
+		},
+		{
+			name:         "Uppercase random key",
+			key:          "123E4567-E89B-12D3-A456-426614174000",
+			expectedType: PIX_KEY_EVP,
+			expectedKey:  "123e4567-e89b-12d3-a456-426614174000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := ParsePixKey(tt.key)
+			if err != nil {
+				t.Fatalf("ParsePixKey() unexpected error: %v", err)
+			}
+			if key.Type != tt.expectedType {
+				t.Errorf("ParsePixKey() Type = %v, expected %v", key.Type, tt.expectedType)
+			}
+			if key.Key != tt.expectedKey {
+				t.Errorf("ParsePixKey() Key = %v, expected %v", key.Key, tt.expectedKey)
+			}
+		})
+	}
+}
+
+// TestValidatePixKey_InvalidCases tests invalid PIX keys
+func TestValidatePixKey_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			key:      11144477735,
+			expected: "PIX key must be a string",
+		},
+		{
+			name:     "Empty key",
+			key:      "  ",
+			expected: "PIX key cannot be empty",
+		},
+		{
+			name:     "Phone with foreign country code",
+			key:      "+1 202 555 0123",
+			expected: "PIX phone key must start with +55",
+		},
+		{
+			name:     "Phone with invalid area code",
+			key:      "+55 20 99504-8710",
+			expected: "invalid area code (DDD)",
+		},
+		{
+			name:     "Email longer than 77 characters",
+			key:      strings.Repeat("a", 66) + "@example.com",
+			expected: "PIX email key must be at most 77 characters long",
+		},
+		{
+			name:     "Invalid email",
+			key:      "user@example",
+			expected: "invalid email format",
+		},
+		{
+			name:     "Invalid CPF",
+			key:      "111.444.777-36",
+			expected: "invalid CPF check digits",
+		},
+		{
+			name:     "Phone without country code is ambiguous",
+			key:      "4199504871",
+			expected: "document must have 11 (CPF) or 14 (CNPJ) characters",
+		},
+		{
+			name:     "Malformed random key",
+			key:      "123e4567-e89b-12d3-a456-42661417400g",
+			expected: "unrecognized PIX key type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePixKey(tt.key)
+			if err == nil {
+				t.Errorf("ValidatePixKey() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidatePixKey() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
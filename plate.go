@@ -0,0 +1,72 @@
+// Package veritas provides Brazilian vehicle plate validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PlateFormat identifies the layout of a Brazilian vehicle plate.
+type PlateFormat string
+
+const (
+	// PLATE_LEGACY identifies the ABC-1234 layout.
+	PLATE_LEGACY PlateFormat = "legacy"
+	// PLATE_MERCOSUL identifies the ABC1D23 layout.
+	PLATE_MERCOSUL PlateFormat = "mercosul"
+)
+
+var (
+	legacyPlate   = regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`)
+	mercosulPlate = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+)
+
+// Plate is a parsed vehicle plate with separators removed and letters uppercased.
+type Plate struct {
+	Number string
+	Format PlateFormat
+}
+
+// ValidatePlate validates a Brazilian vehicle plate in the legacy or Mercosul format.
+func ValidatePlate(plate interface{}) error {
+	_, err := ParsePlate(plate)
+	return err
+}
+
+// ParsePlate validates a vehicle plate and reports which format it uses.
+func ParsePlate(plate interface{}) (Plate, error) {
+	plateStr, ok := plate.(string)
+	if !ok {
+		return Plate{}, fmt.Errorf("plate must be a string")
+	}
+
+	// Clean the plate string (remove spaces, dots and hyphens)
+	plateStr = strings.ToUpper(regexp.MustCompile(`[\s\.\-]`).ReplaceAllString(plateStr, ""))
+
+	switch {
+	case legacyPlate.MatchString(plateStr):
+		return Plate{Number: plateStr, Format: PLATE_LEGACY}, nil
+	case mercosulPlate.MatchString(plateStr):
+		return Plate{Number: plateStr, Format: PLATE_MERCOSUL}, nil
+	default:
+		return Plate{}, fmt.Errorf("invalid plate format")
+	}
+}
+
+// PlateToMercosul validates a vehicle plate and returns its Mercosul form.
+// Legacy plates have their fifth character converted from a digit to the
+// matching letter (0 becomes A, 1 becomes B and so on), so the same vehicle
+// yields the same value whichever plate it was registered with.
+func PlateToMercosul(plate interface{}) (string, error) {
+	parsed, err := ParsePlate(plate)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Format == PLATE_MERCOSUL {
+		return parsed.Number, nil
+	}
+
+	letter := 'A' + rune(parsed.Number[4]-'0')
+	return parsed.Number[:4] + string(letter) + parsed.Number[5:], nil
+}
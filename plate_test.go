@@ -0,0 +1,148 @@
+// Package veritas provides comprehensive unit tests for vehicle plate validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParsePlate_ValidCases tests valid plates in both formats
+func TestParsePlate_ValidCases(t *testing.T) {
+	tests := []struct {
+		name           string
+		plate          string
+		expectedNumber string
+		expectedFormat PlateFormat
+	}{
+		{
+			name:           "Legacy plate with hyphen",
+			plate:          "ABC-1234",
+			expectedNumber: "ABC1234",
+			expectedFormat: PLATE_LEGACY,
+		},
+		{
+			name:           "Legacy plate lowercase with space",
+			plate:          "abc 1234",
+			expectedNumber: "ABC1234",
+			expectedFormat: PLATE_LEGACY,
+		},
+		{
+			name:           "Mercosul plate",
+			plate:          "ABC1D23",
+			expectedNumber: "ABC1D23",
+			expectedFormat: PLATE_MERCOSUL,
+		},
+		{
+			name:           "Mercosul plate lowercase with hyphen",
+			plate:          "bra-2e19",
+			expectedNumber: "BRA2E19",
+			expectedFormat: PLATE_MERCOSUL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plate, err := ParsePlate(tt.plate)
+			if err != nil {
+				t.Fatalf("ParsePlate() unexpected error: %v", err)
+			}
+			if plate.Number != tt.expectedNumber {
+				t.Errorf("ParsePlate() Number = %v, expected %v", plate.Number, tt.expectedNumber)
+			}
+			if plate.Format != tt.expectedFormat {
+				t.Errorf("ParsePlate() Format = %v, expected %v", plate.Format, tt.expectedFormat)
+			}
+		})
+	}
+}
+
+// TestValidatePlate_InvalidCases tests invalid plates
+func TestValidatePlate_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		plate    interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			plate:    1234,
+			expected: "plate must be a string",
+		},
+		{
+			name:     "Too short",
+			plate:    "ABC123",
+			expected: "invalid plate format",
+		},
+		{
+			name:     "Digits first",
+			plate:    "123ABCD",
+			expected: "invalid plate format",
+		},
+		{
+			name:     "Letter in the fourth position",
+			plate:    "ABCD123",
+			expected: "invalid plate format",
+		},
+		{
+			name:     "Letter in the last position",
+			plate:    "ABC1D2E",
+			expected: "invalid plate format",
+		},
+		{
+			name:     "Accented letter",
+			plate:    "ÁBC1234",
+			expected: "invalid plate format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePlate(tt.plate)
+			if err == nil {
+				t.Errorf("ValidatePlate() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidatePlate() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestPlateToMercosul tests conversion of legacy plates to the Mercosul format
+func TestPlateToMercosul(t *testing.T) {
+	tests := []struct {
+		name     string
+		plate    string
+		expected string
+	}{
+		{
+			name:     "Zero becomes A",
+			plate:    "ABC-1034",
+			expected: "ABC1A34",
+		},
+		{
+			name:     "Nine becomes J",
+			plate:    "XYZ-5987",
+			expected: "XYZ5J87",
+		},
+		{
+			name:     "Mercosul plate is unchanged",
+			plate:    "abc1d23",
+			expected: "ABC1D23",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := PlateToMercosul(tt.plate)
+			if err != nil {
+				t.Fatalf("PlateToMercosul() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("PlateToMercosul() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+
+	if _, err := PlateToMercosul("invalid"); err == nil {
+		t.Errorf("PlateToMercosul() expected error, got nil")
+	}
+}
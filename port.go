@@ -0,0 +1,47 @@
+// Package veritas provides network port validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PORT_MAX is the highest TCP and UDP port number.
+const PORT_MAX = 65535
+
+// ValidatePort validates a TCP or UDP port number between 1 and 65535.
+func ValidatePort(port interface{}) error {
+	_, err := ParsePort(port)
+	return err
+}
+
+// ParsePort validates a port given as an integer or a string and returns it
+// as an int.
+func ParsePort(port interface{}) (int, error) {
+	var portNum int
+	switch p := port.(type) {
+	case string:
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil {
+			return 0, fmt.Errorf("port must be a number")
+		}
+		portNum = n
+	case int:
+		portNum = p
+	case int64:
+		if p < 0 || p > PORT_MAX {
+			return 0, fmt.Errorf("port must be between 1 and %d", PORT_MAX)
+		}
+		portNum = int(p)
+	case uint16:
+		portNum = int(p)
+	default:
+		return 0, fmt.Errorf("unsupported port type: %T", port)
+	}
+
+	if portNum < 1 || portNum > PORT_MAX {
+		return 0, fmt.Errorf("port must be between 1 and %d", PORT_MAX)
+	}
+	return portNum, nil
+}
@@ -0,0 +1,54 @@
+// Package veritas provides comprehensive unit tests for network port validation functions.
+package veritas
+
+import "testing"
+
+// TestParsePort tests port validation and conversion
+func TestParsePort(t *testing.T) {
+	tests := []struct {
+		name          string
+		port          interface{}
+		expectedPort  int
+		expectedError string
+	}{
+		{name: "Integer", port: 8080, expectedPort: 8080},
+		{name: "String", port: "443", expectedPort: 443},
+		{name: "String with spaces", port: " 22 ", expectedPort: 22},
+		{name: "int64", port: int64(5432), expectedPort: 5432},
+		{name: "uint16", port: uint16(65535), expectedPort: 65535},
+		{name: "Lowest port", port: 1, expectedPort: 1},
+		{name: "Highest port", port: "65535", expectedPort: 65535},
+		{name: "Zero", port: 0, expectedError: "port must be between 1 and 65535"},
+		{name: "Negative", port: -1, expectedError: "port must be between 1 and 65535"},
+		{name: "Too high", port: "65536", expectedError: "port must be between 1 and 65535"},
+		{name: "Too high int64", port: int64(1 << 40), expectedError: "port must be between 1 and 65535"},
+		{name: "Not a number", port: "http", expectedError: "port must be a number"},
+		{name: "Empty string", port: "", expectedError: "port must be a number"},
+		{name: "Decimal string", port: "80.5", expectedError: "port must be a number"},
+		{name: "Float", port: 80.0, expectedError: "unsupported port type: float64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port, err := ParsePort(tt.port)
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Fatalf("ParsePort() error = nil, expected %v", tt.expectedError)
+				}
+				if err.Error() != tt.expectedError {
+					t.Errorf("ParsePort() error = %v, expected %v", err, tt.expectedError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParsePort() unexpected error: %v", err)
+			}
+			if port != tt.expectedPort {
+				t.Errorf("ParsePort() = %v, expected %v", port, tt.expectedPort)
+			}
+			if err := ValidatePort(tt.port); err != nil {
+				t.Errorf("ValidatePort() unexpected error: %v", err)
+			}
+		})
+	}
+}
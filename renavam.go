@@ -0,0 +1,48 @@
+// Package veritas provides Brazilian RENAVAM validation functions.
+package veritas
+
+import (
+	"fmt"
+)
+
+// ValidateRENAVAM validates a Brazilian RENAVAM (Registro Nacional de Veículos
+// Automotores). Legacy 9-digit numbers are accepted and zero-padded.
+func ValidateRENAVAM(renavam interface{}) error {
+	_, err := NormalizeRENAVAM(renavam)
+	return err
+}
+
+// NormalizeRENAVAM validates a RENAVAM and returns its 11 digits, zero-padding
+// legacy 9-digit numbers.
+func NormalizeRENAVAM(renavam interface{}) (string, error) {
+	renavamStr, ok := renavam.(string)
+	if !ok {
+		return "", fmt.Errorf("RENAVAM must be a string")
+	}
+
+	// Clean the RENAVAM string (remove non-numeric characters)
+	renavamStr = onlyDigits(renavamStr)
+
+	// Legacy numbers have 9 digits and are padded with two leading zeros
+	if len(renavamStr) == 9 {
+		renavamStr = "00" + renavamStr
+	}
+
+	// Check if RENAVAM has exactly 11 digits
+	if len(renavamStr) != 11 {
+		return "", fmt.Errorf("RENAVAM must have 9 or 11 digits")
+	}
+
+	// Check for invalid sequences (all same digits)
+	if isRepeatedDigits(renavamStr) {
+		return "", fmt.Errorf("RENAVAM cannot be a sequence of identical digits")
+	}
+
+	// Compare with provided check digit
+	checkDigit := weightedSum(renavamStr[:10], []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) * 10 % 11 % 10
+	if int(renavamStr[10]-'0') != checkDigit {
+		return "", fmt.Errorf("invalid RENAVAM check digit")
+	}
+
+	return renavamStr, nil
+}
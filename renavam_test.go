@@ -0,0 +1,94 @@
+// Package veritas provides comprehensive unit tests for RENAVAM validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestValidateRENAVAM_ValidCases tests valid RENAVAM numbers
+func TestValidateRENAVAM_ValidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		renavam  string
+		expected string
+	}{
+		{
+			name:     "Valid 11-digit RENAVAM",
+			renavam:  "00639884962",
+			expected: "00639884962",
+		},
+		{
+			name:     "Valid legacy 9-digit RENAVAM",
+			renavam:  "639884962",
+			expected: "00639884962",
+		},
+		{
+			name:     "Valid RENAVAM with check digit from remainder 10",
+			renavam:  "12345678900",
+			expected: "12345678900",
+		},
+		{
+			name:     "Valid RENAVAM with formatting",
+			renavam:  "0063988496-2",
+			expected: "00639884962",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NormalizeRENAVAM(tt.renavam)
+			if err != nil {
+				t.Fatalf("NormalizeRENAVAM() unexpected error: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("NormalizeRENAVAM() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateRENAVAM_InvalidCases tests invalid RENAVAM numbers
+func TestValidateRENAVAM_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		renavam  interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			renavam:  639884962,
+			expected: "RENAVAM must be a string",
+		},
+		{
+			name:     "10 digits",
+			renavam:  "0639884962",
+			expected: "RENAVAM must have 9 or 11 digits",
+		},
+		{
+			name:     "All same digits",
+			renavam:  "11111111111",
+			expected: "RENAVAM cannot be a sequence of identical digits",
+		},
+		{
+			name:     "Invalid check digit",
+			renavam:  "00639884963",
+			expected: "invalid RENAVAM check digit",
+		},
+		{
+			name:     "Invalid legacy check digit",
+			renavam:  "639884961",
+			expected: "invalid RENAVAM check digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRENAVAM(tt.renavam)
+			if err == nil {
+				t.Errorf("ValidateRENAVAM() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateRENAVAM() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
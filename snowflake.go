@@ -0,0 +1,77 @@
+// Package veritas provides Snowflake ID validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SNOWFLAKE_TWITTER_EPOCH is the epoch of Twitter (X) Snowflake IDs, in
+	// Unix milliseconds.
+	SNOWFLAKE_TWITTER_EPOCH int64 = 1288834974657
+	// SNOWFLAKE_DISCORD_EPOCH is the epoch of Discord Snowflake IDs, in Unix
+	// milliseconds.
+	SNOWFLAKE_DISCORD_EPOCH int64 = 1420070400000
+)
+
+// Snowflake is a validated Snowflake ID: a 63-bit integer holding a 41-bit
+// millisecond timestamp, a 10-bit machine ID and a 12-bit sequence number.
+type Snowflake struct {
+	ID uint64
+	// Time is the millisecond creation time, in UTC.
+	Time      time.Time
+	MachineID int
+	Sequence  int
+}
+
+// ValidateSnowflake validates a Snowflake ID with timestamps counted from
+// epoch, in Unix milliseconds.
+func ValidateSnowflake(id interface{}, epoch int64) error {
+	_, err := ParseSnowflake(id, epoch)
+	return err
+}
+
+// ParseSnowflake validates a Snowflake ID given as a decimal string or an
+// integer, and returns its creation time, machine ID and sequence number.
+func ParseSnowflake(id interface{}, epoch int64) (Snowflake, error) {
+	var idNum uint64
+	switch v := id.(type) {
+	case string:
+		n, err := strconv.ParseUint(strings.TrimSpace(v), 10, 64)
+		if err != nil {
+			return Snowflake{}, fmt.Errorf("snowflake must be a decimal number")
+		}
+		idNum = n
+	case int:
+		if v < 0 {
+			return Snowflake{}, fmt.Errorf("snowflake must be positive")
+		}
+		idNum = uint64(v)
+	case int64:
+		if v < 0 {
+			return Snowflake{}, fmt.Errorf("snowflake must be positive")
+		}
+		idNum = uint64(v)
+	case uint64:
+		idNum = v
+	default:
+		return Snowflake{}, fmt.Errorf("unsupported snowflake type: %T", id)
+	}
+
+	if idNum == 0 {
+		return Snowflake{}, fmt.Errorf("snowflake must be positive")
+	}
+	if idNum>>63 != 0 {
+		return Snowflake{}, fmt.Errorf("snowflake must fit in 63 bits")
+	}
+
+	return Snowflake{
+		ID:        idNum,
+		Time:      time.UnixMilli(epoch + int64(idNum>>22)).UTC(),
+		MachineID: int(idNum >> 12 & 0x3ff),
+		Sequence:  int(idNum & 0xfff),
+	}, nil
+}
@@ -0,0 +1,109 @@
+// Package veritas provides comprehensive unit tests for Snowflake ID validation functions.
+package veritas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseSnowflake_ValidCases tests timestamp, machine and sequence extraction of valid Snowflake IDs
+func TestParseSnowflake_ValidCases(t *testing.T) {
+	tests := []struct {
+		name              string
+		id                interface{}
+		epoch             int64
+		expectedID        uint64
+		expectedTime      time.Time
+		expectedMachineID int
+		expectedSequence  int
+	}{
+		{
+			name:              "Discord ID",
+			id:                "175928847299117063",
+			epoch:             SNOWFLAKE_DISCORD_EPOCH,
+			expectedID:        175928847299117063,
+			expectedTime:      time.Date(2016, 4, 30, 11, 18, 25, 796000000, time.UTC),
+			expectedMachineID: 32,
+			expectedSequence:  7,
+		},
+		{
+			name:              "Discord ID as int",
+			id:                175928847299117063,
+			epoch:             SNOWFLAKE_DISCORD_EPOCH,
+			expectedID:        175928847299117063,
+			expectedTime:      time.Date(2016, 4, 30, 11, 18, 25, 796000000, time.UTC),
+			expectedMachineID: 32,
+			expectedSequence:  7,
+		},
+		{
+			name:              "Twitter ID as int64",
+			id:                int64(1541815603606036480),
+			epoch:             SNOWFLAKE_TWITTER_EPOCH,
+			expectedID:        1541815603606036480,
+			expectedTime:      time.Date(2022, 6, 28, 16, 7, 40, 105000000, time.UTC),
+			expectedMachineID: 378,
+			expectedSequence:  0,
+		},
+		{
+			name:              "uint64",
+			id:                uint64(1 << 22),
+			epoch:             SNOWFLAKE_DISCORD_EPOCH,
+			expectedID:        1 << 22,
+			expectedTime:      time.UnixMilli(SNOWFLAKE_DISCORD_EPOCH + 1).UTC(),
+			expectedMachineID: 0,
+			expectedSequence:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snowflake, err := ParseSnowflake(tt.id, tt.epoch)
+			if err != nil {
+				t.Fatalf("ParseSnowflake() unexpected error: %v", err)
+			}
+			if snowflake.ID != tt.expectedID {
+				t.Errorf("ParseSnowflake() ID = %v, expected %v", snowflake.ID, tt.expectedID)
+			}
+			if !snowflake.Time.Equal(tt.expectedTime) {
+				t.Errorf("ParseSnowflake() Time = %v, expected %v", snowflake.Time, tt.expectedTime)
+			}
+			if snowflake.MachineID != tt.expectedMachineID {
+				t.Errorf("ParseSnowflake() MachineID = %v, expected %v", snowflake.MachineID, tt.expectedMachineID)
+			}
+			if snowflake.Sequence != tt.expectedSequence {
+				t.Errorf("ParseSnowflake() Sequence = %v, expected %v", snowflake.Sequence, tt.expectedSequence)
+			}
+		})
+	}
+}
+
+// TestValidateSnowflake_InvalidCases tests invalid Snowflake IDs
+func TestValidateSnowflake_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       interface{}
+		expected string
+	}{
+		{name: "Unsupported type", id: 1.5, expected: "unsupported snowflake type: float64"},
+		{name: "Empty string", id: "", expected: "snowflake must be a decimal number"},
+		{name: "Letters", id: "17592884729911706a", expected: "snowflake must be a decimal number"},
+		{name: "Negative string", id: "-1", expected: "snowflake must be a decimal number"},
+		{name: "Negative int", id: -1, expected: "snowflake must be positive"},
+		{name: "Negative int64", id: int64(-1), expected: "snowflake must be positive"},
+		{name: "Zero", id: "0", expected: "snowflake must be positive"},
+		{name: "Sign bit set", id: "9223372036854775808", expected: "snowflake must fit in 63 bits"},
+		{name: "Too large for 64 bits", id: "18446744073709551616", expected: "snowflake must be a decimal number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSnowflake(tt.id, SNOWFLAKE_DISCORD_EPOCH)
+			if err == nil {
+				t.Fatalf("ValidateSnowflake() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateSnowflake() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
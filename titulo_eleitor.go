@@ -0,0 +1,94 @@
+// Package veritas provides Brazilian Título de Eleitor validation functions.
+package veritas
+
+import (
+	"fmt"
+)
+
+// tituloEleitorUFs maps the TSE state codes used in voter registration
+// numbers to their UF. The TSE numbers states in its own order, so these codes
+// differ from the IBGE codes in ibgeUFs. Code 28 (ZZ) is used for voters living abroad.
+var tituloEleitorUFs = map[string]string{
+	"01": "SP", "02": "MG", "03": "RJ", "04": "RS", "05": "BA", "06": "PR", "07": "CE",
+	"08": "PE", "09": "SC", "10": "GO", "11": "MA", "12": "PB", "13": "PA", "14": "ES",
+	"15": "PI", "16": "RN", "17": "AL", "18": "MT", "19": "MS", "20": "DF", "21": "SE",
+	"22": "AM", "23": "RO", "24": "AC", "25": "AP", "26": "RR", "27": "TO", "28": "ZZ",
+}
+
+// TituloEleitor is a parsed Brazilian voter registration number.
+type TituloEleitor struct {
+	Number      string
+	Sequence    string
+	UFCode      string
+	UF          string
+	CheckDigits string
+}
+
+// ValidateTituloEleitor validates a Brazilian Título de Eleitor (voter registration number).
+func ValidateTituloEleitor(titulo interface{}) error {
+	_, err := ParseTituloEleitor(titulo)
+	return err
+}
+
+// ParseTituloEleitor validates a Título de Eleitor and returns its sequential
+// number, UF code and check digits.
+func ParseTituloEleitor(titulo interface{}) (TituloEleitor, error) {
+	tituloStr, ok := titulo.(string)
+	if !ok {
+		return TituloEleitor{}, fmt.Errorf("título de eleitor must be a string")
+	}
+
+	// Clean the título string (remove non-numeric characters)
+	tituloStr = onlyDigits(tituloStr)
+
+	// Check if título has exactly 12 digits
+	if len(tituloStr) != 12 {
+		return TituloEleitor{}, fmt.Errorf("título de eleitor must have exactly 12 digits")
+	}
+
+	// Check for invalid sequences (all same digits)
+	if isRepeatedDigits(tituloStr) {
+		return TituloEleitor{}, fmt.Errorf("título de eleitor cannot be a sequence of identical digits")
+	}
+
+	sequence, ufCode, checkDigits := tituloStr[:8], tituloStr[8:10], tituloStr[10:]
+	uf, ok := tituloEleitorUFs[ufCode]
+	if !ok {
+		return TituloEleitor{}, fmt.Errorf("invalid título de eleitor UF code")
+	}
+
+	// Compare with provided check digits
+	if checkDigits != tituloEleitorCheckDigits(sequence, ufCode) {
+		return TituloEleitor{}, fmt.Errorf("invalid título de eleitor check digits")
+	}
+
+	return TituloEleitor{
+		Number:      tituloStr,
+		Sequence:    sequence,
+		UFCode:      ufCode,
+		UF:          uf,
+		CheckDigits: checkDigits,
+	}, nil
+}
+
+// tituloEleitorCheckDigits calculates the two check digits for a sequential
+// number and UF code.
+func tituloEleitorCheckDigits(sequence, ufCode string) string {
+	firstCheckDigit := tituloEleitorCheckDigit(weightedSum(sequence, []int{2, 3, 4, 5, 6, 7, 8, 9}), ufCode)
+	secondSum := weightedSum(ufCode, []int{7, 8}) + firstCheckDigit*9
+	secondCheckDigit := tituloEleitorCheckDigit(secondSum, ufCode)
+	return fmt.Sprintf("%d%d", firstCheckDigit, secondCheckDigit)
+}
+
+// tituloEleitorCheckDigit reduces a weighted sum to a check digit. São Paulo
+// and Minas Gerais use 1 instead of 0 when the remainder is zero.
+func tituloEleitorCheckDigit(sum int, ufCode string) int {
+	remainder := sum % 11
+	if remainder == 10 {
+		return 0
+	}
+	if remainder == 0 && (ufCode == "01" || ufCode == "02") {
+		return 1
+	}
+	return remainder
+}
@@ -0,0 +1,107 @@
+// Package veritas provides comprehensive unit tests for Título de Eleitor validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestParseTituloEleitor_ValidCases tests valid voter registration numbers
+func TestParseTituloEleitor_ValidCases(t *testing.T) {
+	tests := []struct {
+		name        string
+		titulo      string
+		expectedUF  string
+		expectedSeq string
+	}{
+		{
+			name:        "Valid título from Santa Catarina",
+			titulo:      "004356870906",
+			expectedUF:  "SC",
+			expectedSeq: "00435687",
+		},
+		{
+			name:        "Valid título from Paraná with spaces",
+			titulo:      "1023 8501 0671",
+			expectedUF:  "PR",
+			expectedSeq: "10238501",
+		},
+		{
+			name:        "São Paulo remainder zero yields one",
+			titulo:      "0000 0000 0116",
+			expectedUF:  "SP",
+			expectedSeq: "00000000",
+		},
+		{
+			name:        "Rio de Janeiro remainder zero yields zero",
+			titulo:      "000000000302",
+			expectedUF:  "RJ",
+			expectedSeq: "00000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			titulo, err := ParseTituloEleitor(tt.titulo)
+			if err != nil {
+				t.Fatalf("ParseTituloEleitor() unexpected error: %v", err)
+			}
+			if titulo.UF != tt.expectedUF {
+				t.Errorf("ParseTituloEleitor() UF = %v, expected %v", titulo.UF, tt.expectedUF)
+			}
+			if titulo.Sequence != tt.expectedSeq {
+				t.Errorf("ParseTituloEleitor() Sequence = %v, expected %v", titulo.Sequence, tt.expectedSeq)
+			}
+		})
+	}
+}
+
+// TestValidateTituloEleitor_InvalidCases tests invalid voter registration numbers
+func TestValidateTituloEleitor_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		titulo   interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			titulo:   4356870906,
+			expected: "título de eleitor must be a string",
+		},
+		{
+			name:     "Too short",
+			titulo:   "00435687090",
+			expected: "título de eleitor must have exactly 12 digits",
+		},
+		{
+			name:     "All same digits",
+			titulo:   "111111111111",
+			expected: "título de eleitor cannot be a sequence of identical digits",
+		},
+		{
+			name:     "Unknown UF code",
+			titulo:   "004356872906",
+			expected: "invalid título de eleitor UF code",
+		},
+		{
+			name:     "Invalid check digits",
+			titulo:   "004356870907",
+			expected: "invalid título de eleitor check digits",
+		},
+		{
+			name:     "São Paulo with zero instead of one",
+			titulo:   "000000000106",
+			expected: "invalid título de eleitor check digits",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTituloEleitor(tt.titulo)
+			if err == nil {
+				t.Errorf("ValidateTituloEleitor() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateTituloEleitor() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Package veritas provides Brazilian UF (state) validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ibgeUFs maps the 2-digit IBGE codes of the 26 states and the Distrito
+// Federal to their UF. These codes prefix IBGE municipality codes and fiscal
+// document access keys.
+var ibgeUFs = map[string]string{
+	"11": "RO", "12": "AC", "13": "AM", "14": "RR", "15": "PA", "16": "AP", "17": "TO",
+	"21": "MA", "22": "PI", "23": "CE", "24": "RN", "25": "PB", "26": "PE", "27": "AL",
+	"28": "SE", "29": "BA", "31": "MG", "32": "ES", "33": "RJ", "35": "SP", "41": "PR",
+	"42": "SC", "43": "RS", "50": "MS", "51": "MT", "52": "GO", "53": "DF",
+}
+
+// ValidateUF validates a UF abbreviation (sigla) such as "SP".
+func ValidateUF(uf interface{}) error {
+	ufStr, ok := uf.(string)
+	if !ok {
+		return fmt.Errorf("UF must be a string")
+	}
+
+	_, err := IBGECodeFromUF(ufStr)
+	return err
+}
+
+// UFFromIBGECode returns the UF of a 2-digit IBGE state code.
+func UFFromIBGECode(code string) (string, error) {
+	uf, ok := ibgeUFs[strings.TrimSpace(code)]
+	if !ok {
+		return "", fmt.Errorf("invalid IBGE UF code: %s", code)
+	}
+	return uf, nil
+}
+
+// IBGECodeFromUF returns the 2-digit IBGE state code of a UF.
+func IBGECodeFromUF(uf string) (string, error) {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+	for code, sigla := range ibgeUFs {
+		if sigla == uf {
+			return code, nil
+		}
+	}
+	return "", fmt.Errorf("invalid UF: %s", uf)
+}
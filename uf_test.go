@@ -0,0 +1,89 @@
+// Package veritas provides comprehensive unit tests for Brazilian UF validation functions.
+package veritas
+
+import (
+	"testing"
+)
+
+// TestValidateUF tests UF abbreviation validation
+func TestValidateUF(t *testing.T) {
+	tests := []struct {
+		name     string
+		uf       interface{}
+		expected string
+	}{
+		{name: "Uppercase UF", uf: "SP", expected: ""},
+		{name: "Lowercase UF with spaces", uf: " df ", expected: ""},
+		{name: "Unknown UF", uf: "XX", expected: "invalid UF: XX"},
+		{name: "Título abroad code is not a UF", uf: "ZZ", expected: "invalid UF: ZZ"},
+		{name: "Integer input", uf: 35, expected: "UF must be a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUF(tt.uf)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateUF() error = %v, expected nil", err)
+				}
+			} else if err == nil || err.Error() != tt.expected {
+				t.Errorf("ValidateUF() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestUFFromIBGECode tests conversion from IBGE state codes to UFs
+func TestUFFromIBGECode(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		expected string
+		err      string
+	}{
+		{name: "Rondônia", code: "11", expected: "RO"},
+		{name: "São Paulo", code: "35", expected: "SP"},
+		{name: "Distrito Federal", code: "53", expected: "DF"},
+		{name: "Unused code", code: "34", err: "invalid IBGE UF code: 34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uf, err := UFFromIBGECode(tt.code)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Errorf("UFFromIBGECode() error = %v, expected %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil || uf != tt.expected {
+				t.Errorf("UFFromIBGECode() = %v, %v, expected %v", uf, err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestIBGECodeFromUF tests that every UF converts back to its IBGE code
+func TestIBGECodeFromUF(t *testing.T) {
+	if len(ibgeUFs) != 27 {
+		t.Errorf("ibgeUFs has %d entries, expected 27", len(ibgeUFs))
+	}
+	for code, uf := range ibgeUFs {
+		got, err := IBGECodeFromUF(uf)
+		if err != nil || got != code {
+			t.Errorf("IBGECodeFromUF(%s) = %v, %v, expected %v", uf, got, err, code)
+		}
+	}
+}
+
+// TestTituloEleitorUFs_AreValid tests that the TSE state table only uses known UFs
+func TestTituloEleitorUFs_AreValid(t *testing.T) {
+	for code, uf := range tituloEleitorUFs {
+		if uf == "ZZ" {
+			continue
+		}
+		if err := ValidateUF(uf); err != nil {
+			t.Errorf("tituloEleitorUFs[%s] = %v: %v", code, uf, err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package veritas provides ULID validation functions.
+package veritas
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// crockfordAlphabet is the Crockford base32 alphabet used by ULIDs.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ULID is a validated Universally Unique Lexicographically Sortable Identifier.
+type ULID struct {
+	// ULID is the identifier in uppercase.
+	ULID string
+	// Time is the millisecond creation time, in UTC.
+	Time time.Time
+	// Randomness is the 16-character random part.
+	Randomness string
+}
+
+// ValidateULID validates a ULID.
+func ValidateULID(ulid interface{}) error {
+	_, err := ParseULID(ulid)
+	return err
+}
+
+// ParseULID validates a 26-character ULID and returns its creation time.
+func ParseULID(ulid interface{}) (ULID, error) {
+	ulidStr, ok := ulid.(string)
+	if !ok {
+		return ULID{}, fmt.Errorf("ULID must be a string")
+	}
+
+	// Clean the ULID string (remove spaces and use uppercase)
+	ulidStr = strings.ToUpper(strings.TrimSpace(ulidStr))
+
+	// Check if ULID has exactly 26 characters
+	if len(ulidStr) != 26 {
+		return ULID{}, fmt.Errorf("ULID must have exactly 26 characters")
+	}
+
+	var millis int64
+	for i, c := range ulidStr {
+		value := strings.IndexRune(crockfordAlphabet, c)
+		if value < 0 {
+			return ULID{}, fmt.Errorf("invalid ULID character: %q", c)
+		}
+		if i < 10 {
+			millis = millis<<5 | int64(value)
+		}
+	}
+
+	// 26 characters hold 130 bits; the first one must fit in the top 3 bits of 128
+	if ulidStr[0] > '7' {
+		return ULID{}, fmt.Errorf("ULID timestamp overflows 48 bits")
+	}
+
+	return ULID{ULID: ulidStr, Time: time.UnixMilli(millis).UTC(), Randomness: ulidStr[10:]}, nil
+}
+
+// Compare returns -1, 0 or 1 as u sorts before, equal to or after other.
+// ULIDs sort by creation time, and ULIDs generated monotonically within the
+// same millisecond sort in generation order.
+func (u ULID) Compare(other ULID) int {
+	return strings.Compare(u.ULID, other.ULID)
+}
@@ -0,0 +1,128 @@
+// Package veritas provides comprehensive unit tests for ULID validation functions.
+package veritas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseULID_ValidCases tests timestamp extraction of valid ULIDs
+func TestParseULID_ValidCases(t *testing.T) {
+	tests := []struct {
+		name               string
+		ulid               string
+		expectedULID       string
+		expectedTime       time.Time
+		expectedRandomness string
+	}{
+		{
+			name:               "Specification example",
+			ulid:               "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			expectedULID:       "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			expectedTime:       time.Date(2016, 7, 30, 23, 54, 10, 259000000, time.UTC),
+			expectedRandomness: "TSV4RRFFQ69G5FAV",
+		},
+		{
+			name:               "Lowercase",
+			ulid:               "01arz3ndektsv4rrffq69g5fav",
+			expectedULID:       "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+			expectedTime:       time.Date(2016, 7, 30, 23, 54, 10, 259000000, time.UTC),
+			expectedRandomness: "TSV4RRFFQ69G5FAV",
+		},
+		{
+			name:               "Zero ULID",
+			ulid:               "00000000000000000000000000",
+			expectedULID:       "00000000000000000000000000",
+			expectedTime:       time.Unix(0, 0).UTC(),
+			expectedRandomness: "0000000000000000",
+		},
+		{
+			name:               "Max ULID",
+			ulid:               "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+			expectedULID:       "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+			expectedTime:       time.UnixMilli(1<<48 - 1).UTC(),
+			expectedRandomness: "ZZZZZZZZZZZZZZZZ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ulid, err := ParseULID(tt.ulid)
+			if err != nil {
+				t.Fatalf("ParseULID() unexpected error: %v", err)
+			}
+			if ulid.ULID != tt.expectedULID {
+				t.Errorf("ParseULID() ULID = %v, expected %v", ulid.ULID, tt.expectedULID)
+			}
+			if !ulid.Time.Equal(tt.expectedTime) {
+				t.Errorf("ParseULID() Time = %v, expected %v", ulid.Time, tt.expectedTime)
+			}
+			if ulid.Randomness != tt.expectedRandomness {
+				t.Errorf("ParseULID() Randomness = %v, expected %v", ulid.Randomness, tt.expectedRandomness)
+			}
+		})
+	}
+}
+
+// TestValidateULID_InvalidCases tests invalid ULIDs
+func TestValidateULID_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		ulid     interface{}
+		expected string
+	}{
+		{name: "Integer input", ulid: 1, expected: "ULID must be a string"},
+		{name: "Empty string", ulid: "", expected: "ULID must have exactly 26 characters"},
+		{name: "Too short", ulid: "01ARZ3NDEKTSV4RRFFQ69G5FA", expected: "ULID must have exactly 26 characters"},
+		{name: "Letter I", ulid: "01ARZ3NDEKTSV4RRFFQ69G5FAI", expected: `invalid ULID character: 'I'`},
+		{name: "Letter U", ulid: "01ARZ3NDEKTSV4RRFFQ69G5FAU", expected: `invalid ULID character: 'U'`},
+		{name: "Hyphen", ulid: "01ARZ3NDEK-SV4RRFFQ69G5FAV", expected: `invalid ULID character: '-'`},
+		{name: "Timestamp overflow", ulid: "80000000000000000000000000", expected: "ULID timestamp overflows 48 bits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateULID(tt.ulid)
+			if err == nil {
+				t.Fatalf("ValidateULID() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateULID() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
+
+// TestULIDCompare tests ordering of ULIDs by time and monotonic randomness
+func TestULIDCompare(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected int
+	}{
+		{name: "Earlier millisecond", a: "01ARZ3NDEKTSV4RRFFQ69G5FAV", b: "01ARZ3NDEMTSV4RRFFQ69G5FAV", expected: -1},
+		{name: "Later millisecond", a: "01ARZ3NDEMTSV4RRFFQ69G5FAV", b: "01ARZ3NDEKZZZZZZZZZZZZZZZZ", expected: 1},
+		{name: "Monotonic increment", a: "01ARZ3NDEKTSV4RRFFQ69G5FAV", b: "01ARZ3NDEKTSV4RRFFQ69G5FAW", expected: -1},
+		{name: "Monotonic increment with carry", a: "01ARZ3NDEKTSV4RRFFQ69G5FAZ", b: "01ARZ3NDEKTSV4RRFFQ69G5FB0", expected: -1},
+		{name: "Equal ignoring case", a: "01ARZ3NDEKTSV4RRFFQ69G5FAV", b: "01arz3ndektsv4rrffq69g5fav", expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := ParseULID(tt.a)
+			if err != nil {
+				t.Fatalf("ParseULID(%v) unexpected error: %v", tt.a, err)
+			}
+			b, err := ParseULID(tt.b)
+			if err != nil {
+				t.Fatalf("ParseULID(%v) unexpected error: %v", tt.b, err)
+			}
+			if got := a.Compare(b); got != tt.expected {
+				t.Errorf("Compare() = %v, expected %v", got, tt.expected)
+			}
+			if got := b.Compare(a); got != -tt.expected {
+				t.Errorf("reversed Compare() = %v, expected %v", got, -tt.expected)
+			}
+		})
+	}
+}
@@ -2,13 +2,34 @@
 package veritas
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
 	"net/url"
+	"syscall"
 	"time"
 )
 
-// ValidateURL validates a URL format.
+// urlClient checks that URLs are accessible. Its dialer refuses non-global
+// addresses, so that redirects and DNS rebinding cannot reach internal
+// networks, and it uses no proxy, whose address would be checked instead of
+// the target's.
+var urlClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: refuseNonGlobalAddress,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+}
+
+// ValidateURL validates a URL format and checks that it returns status 200.
+// Hosts resolving to addresses that ClassifyIP does not classify as global,
+// such as loopback and private addresses, are rejected before any request.
 func ValidateURL(urlStr interface{}) error {
 	urlStr, ok := urlStr.(string)
 	if !ok {
@@ -36,12 +57,13 @@ func ValidateURL(urlStr interface{}) error {
 		return fmt.Errorf("URL must include a host")
 	}
 
-	// Check if URL returns 200 status code
-	client := &http.Client{
-		Timeout: 10 * time.Second,
+	// Check if host resolves to global addresses only
+	if err := checkURLHost(parsedURL.Hostname()); err != nil {
+		return err
 	}
 
-	resp, err := client.Head(urlStr.(string))
+	// Check if URL returns 200 status code
+	resp, err := urlClient.Head(urlStr.(string))
 	if err != nil {
 		return fmt.Errorf("URL is not accessible: %w", err)
 	}
@@ -53,3 +75,45 @@ func ValidateURL(urlStr interface{}) error {
 
 	return nil
 }
+
+// checkURLHost resolves a URL host and rejects it when any of its addresses
+// is not global.
+func checkURLHost(host string) error {
+	var addrs []netip.Addr
+	if addr, err := netip.ParseAddr(host); err == nil {
+		addrs = []netip.Addr{addr}
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		addrs, err = net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		if err != nil {
+			return fmt.Errorf("URL is not accessible: %w", err)
+		}
+	}
+
+	for _, addr := range addrs {
+		if class := ClassifyIP(addr); class != IP_GLOBAL {
+			return fmt.Errorf("URL host %s resolves to %s address %s", host, class, addr)
+		}
+	}
+	return nil
+}
+
+// refuseNonGlobalAddress is a net.Dialer Control hook refusing connections
+// to addresses that are not global. It sees the resolved address of every
+// connection, including those made to follow redirects.
+func refuseNonGlobalAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if class := ClassifyIP(addr); class != IP_GLOBAL {
+		return fmt.Errorf("connection to %s address %s refused", class, addr)
+	}
+	return nil
+}
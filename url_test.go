@@ -4,6 +4,8 @@ package veritas
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 )
 
@@ -349,3 +351,126 @@ func TestValidateURL_HTTPStatusCodes(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateURL_NonGlobalHosts tests that hosts resolving to non-global
+// addresses are rejected before any request is sent
+func TestValidateURL_NonGlobalHosts(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+	port := server.URL[strings.LastIndex(server.URL, ":")+1:]
+
+	tests := []struct {
+		name     string
+		url      string
+		expected string
+	}{
+		{
+			name:     "Loopback server",
+			url:      server.URL,
+			expected: "URL host 127.0.0.1 resolves to LOOPBACK address 127.0.0.1",
+		},
+		{
+			name:     "IPv6 loopback",
+			url:      "http://[::1]:" + port,
+			expected: "URL host ::1 resolves to LOOPBACK address ::1",
+		},
+		{
+			name:     "Private network",
+			url:      "http://10.0.0.1/",
+			expected: "URL host 10.0.0.1 resolves to PRIVATE address 10.0.0.1",
+		},
+		{
+			name:     "Cloud metadata endpoint",
+			url:      "http://169.254.169.254/latest/meta-data/",
+			expected: "URL host 169.254.169.254 resolves to LINK_LOCAL address 169.254.169.254",
+		},
+		{
+			name:     "Unspecified address",
+			url:      "http://0.0.0.0:" + port,
+			expected: "URL host 0.0.0.0 resolves to UNSPECIFIED address 0.0.0.0",
+		},
+		{
+			name:     "IPv4-mapped loopback",
+			url:      "http://[::ffff:127.0.0.1]:" + port,
+			expected: "URL host ::ffff:127.0.0.1 resolves to LOOPBACK address ::ffff:127.0.0.1",
+		},
+		{
+			name:     "NAT64 loopback",
+			url:      "http://[64:ff9b::7f00:1]:" + port,
+			expected: "URL host 64:ff9b::7f00:1 resolves to LOOPBACK address 64:ff9b::7f00:1",
+		},
+		{
+			name:     "6to4 private network",
+			url:      "http://[2002:a00:1::]/",
+			expected: "URL host 2002:a00:1:: resolves to PRIVATE address 2002:a00:1::",
+		},
+		{
+			name:     "localhost",
+			url:      "http://localhost:" + port,
+			expected: "URL host localhost resolves to LOOPBACK address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateURL(tt.url)
+			if err == nil {
+				t.Fatalf("ValidateURL() error = nil, expected %v", tt.expected)
+			}
+			if !strings.HasPrefix(err.Error(), tt.expected) {
+				t.Errorf("ValidateURL() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+
+	if n := requests.Load(); n != 0 {
+		t.Errorf("server received %d requests, expected none", n)
+	}
+}
+
+// TestURLClient_RefusesNonGlobalAddresses tests that the client refuses to
+// connect to non-global addresses reached without the host check, as through
+// redirects or DNS rebinding
+func TestURLClient_RefusesNonGlobalAddresses(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	resp, err := urlClient.Head(server.URL)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("urlClient.Head() error = nil, expected a refused connection")
+	}
+	if !strings.Contains(err.Error(), "connection to LOOPBACK address 127.0.0.1 refused") {
+		t.Errorf("urlClient.Head() error = %v, expected a refused connection", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("server received %d requests, expected none", n)
+	}
+
+	tests := []struct {
+		address  string
+		expected string
+	}{
+		{address: "127.0.0.1:80", expected: "connection to LOOPBACK address 127.0.0.1 refused"},
+		{address: "[fd00::1]:443", expected: "connection to PRIVATE address fd00::1 refused"},
+		{address: "[2002:7f00:1::]:443", expected: "connection to LOOPBACK address 2002:7f00:1:: refused"},
+		{address: "8.8.8.8:443"},
+		{address: "[2606:4700:4700::1111]:443"},
+	}
+	for _, tt := range tests {
+		err := refuseNonGlobalAddress("tcp", tt.address, nil)
+		if tt.expected == "" {
+			if err != nil {
+				t.Errorf("refuseNonGlobalAddress(%s) error = %v, expected nil", tt.address, err)
+			}
+		} else if err == nil || err.Error() != tt.expected {
+			t.Errorf("refuseNonGlobalAddress(%s) error = %v, expected %v", tt.address, err, tt.expected)
+		}
+	}
+}
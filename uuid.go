@@ -0,0 +1,171 @@
+// Package veritas provides UUID validation functions.
+package veritas
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UUIDVersion is the version of an RFC 9562 UUID.
+type UUIDVersion int
+
+const (
+	// UUID_V1 identifies time-based UUIDs.
+	UUID_V1 UUIDVersion = 1
+	// UUID_V3 identifies name-based UUIDs using MD5.
+	UUID_V3 UUIDVersion = 3
+	// UUID_V4 identifies random UUIDs.
+	UUID_V4 UUIDVersion = 4
+	// UUID_V5 identifies name-based UUIDs using SHA-1.
+	UUID_V5 UUIDVersion = 5
+	// UUID_V6 identifies time-based UUIDs with the timestamp in sortable order.
+	UUID_V6 UUIDVersion = 6
+	// UUID_V7 identifies time-ordered UUIDs based on the Unix time in milliseconds.
+	UUID_V7 UUIDVersion = 7
+	// UUID_V8 identifies custom UUIDs.
+	UUID_V8 UUIDVersion = 8
+)
+
+// UUIDVariant is the layout family of a UUID.
+type UUIDVariant string
+
+const (
+	// UUID_VARIANT_NCS identifies the reserved NCS backward compatible variant,
+	// which includes the nil UUID.
+	UUID_VARIANT_NCS UUIDVariant = "NCS"
+	// UUID_VARIANT_RFC9562 identifies the variant of RFC 9562 (formerly RFC
+	// 4122), the only one with versions.
+	UUID_VARIANT_RFC9562 UUIDVariant = "RFC9562"
+	// UUID_VARIANT_MICROSOFT identifies the reserved Microsoft GUID variant.
+	UUID_VARIANT_MICROSOFT UUIDVariant = "MICROSOFT"
+	// UUID_VARIANT_FUTURE identifies the variant reserved for future use,
+	// which includes the max UUID.
+	UUID_VARIANT_FUTURE UUIDVariant = "FUTURE"
+)
+
+// uuidGregorianOffset is the number of 100-nanosecond intervals between the
+// UUID epoch (1582-10-15) and the Unix epoch.
+const uuidGregorianOffset = 122192928000000000
+
+// UUID is a validated UUID.
+type UUID struct {
+	// UUID is the UUID in lowercase hyphenated form.
+	UUID    string
+	Variant UUIDVariant
+	// Version is 0 for UUIDs without the RFC 9562 variant.
+	Version UUIDVersion
+	// Time is the creation time of version 1, 6 and 7 UUIDs, in UTC, and the
+	// zero time for the others.
+	Time time.Time
+}
+
+// ValidateUUID validates a UUID. When versions are given, the UUID must have
+// the RFC 9562 variant and one of those versions.
+func ValidateUUID(uuid interface{}, versions ...UUIDVersion) error {
+	parsed, err := ParseUUID(uuid)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return nil
+	}
+	if parsed.Variant != UUID_VARIANT_RFC9562 {
+		return fmt.Errorf("UUID must have the RFC 9562 variant")
+	}
+	for _, version := range versions {
+		if version == parsed.Version {
+			return nil
+		}
+	}
+	return fmt.Errorf("UUID version %d is not allowed", parsed.Version)
+}
+
+// ParseUUID validates a UUID in hyphenated form, optionally in braces or with
+// the urn:uuid: prefix, or as 32 hexadecimal digits, and returns its variant,
+// version and creation time.
+func ParseUUID(uuid interface{}) (UUID, error) {
+	uuidStr, ok := uuid.(string)
+	if !ok {
+		return UUID{}, fmt.Errorf("UUID must be a string")
+	}
+
+	// Clean the UUID string (remove spaces, the URN prefix and braces)
+	uuidStr = strings.ToLower(strings.TrimSpace(uuidStr))
+	uuidStr = strings.TrimPrefix(uuidStr, "urn:uuid:")
+	if strings.HasPrefix(uuidStr, "{") && strings.HasSuffix(uuidStr, "}") {
+		uuidStr = uuidStr[1 : len(uuidStr)-1]
+	}
+
+	switch len(uuidStr) {
+	case 36:
+		if uuidStr[8] != '-' || uuidStr[13] != '-' || uuidStr[18] != '-' || uuidStr[23] != '-' {
+			return UUID{}, fmt.Errorf("invalid UUID format")
+		}
+		uuidStr = strings.ReplaceAll(uuidStr, "-", "")
+	case 32:
+	default:
+		return UUID{}, fmt.Errorf("UUID must have 32 hexadecimal digits")
+	}
+
+	decoded, err := hex.DecodeString(uuidStr)
+	if err != nil || len(decoded) != 16 {
+		return UUID{}, fmt.Errorf("invalid UUID format")
+	}
+	var b [16]byte
+	copy(b[:], decoded)
+
+	parsed := UUID{UUID: formatUUID(b), Variant: uuidVariant(b[8])}
+	if parsed.Variant != UUID_VARIANT_RFC9562 {
+		return parsed, nil
+	}
+
+	parsed.Version = UUIDVersion(b[6] >> 4)
+	switch parsed.Version {
+	case UUID_V1:
+		timestamp := uint64(binary.BigEndian.Uint16(b[6:8])&0x0fff)<<48 |
+			uint64(binary.BigEndian.Uint16(b[4:6]))<<32 |
+			uint64(binary.BigEndian.Uint32(b[0:4]))
+		parsed.Time = uuidGregorianTime(timestamp)
+	case UUID_V6:
+		timestamp := uint64(binary.BigEndian.Uint32(b[0:4]))<<28 |
+			uint64(binary.BigEndian.Uint16(b[4:6]))<<12 |
+			uint64(binary.BigEndian.Uint16(b[6:8])&0x0fff)
+		parsed.Time = uuidGregorianTime(timestamp)
+	case UUID_V7:
+		millis := int64(binary.BigEndian.Uint64(b[0:8]) >> 16)
+		parsed.Time = time.UnixMilli(millis).UTC()
+	}
+
+	return parsed, nil
+}
+
+// uuidVariant returns the variant encoded in the high bits of octet 8.
+func uuidVariant(octet byte) UUIDVariant {
+	switch {
+	case octet&0x80 == 0:
+		return UUID_VARIANT_NCS
+	case octet&0xc0 == 0x80:
+		return UUID_VARIANT_RFC9562
+	case octet&0xe0 == 0xc0:
+		return UUID_VARIANT_MICROSOFT
+	default:
+		return UUID_VARIANT_FUTURE
+	}
+}
+
+// uuidGregorianTime converts a 60-bit count of 100-nanosecond intervals since
+// the UUID epoch to a time.
+func uuidGregorianTime(timestamp uint64) time.Time {
+	intervals := int64(timestamp) - uuidGregorianOffset
+	return time.Unix(intervals/1e7, intervals%1e7*100).UTC()
+}
+
+// formatUUID formats 16 bytes in lowercase hyphenated form.
+func formatUUID(b [16]byte) string {
+	s := hex.EncodeToString(b[:])
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
@@ -0,0 +1,179 @@
+// Package veritas provides comprehensive unit tests for UUID validation functions.
+package veritas
+
+import (
+	"testing"
+	"time"
+)
+
+// TestParseUUID_ValidCases tests variant, version and timestamp extraction of valid UUIDs
+func TestParseUUID_ValidCases(t *testing.T) {
+	// RFC 9562 test vectors, all generated at 2022-02-22 14:22:22 -05:00
+	rfcTime := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		uuid            string
+		expectedUUID    string
+		expectedVariant UUIDVariant
+		expectedVersion UUIDVersion
+		expectedTime    time.Time
+	}{
+		{
+			name:            "Version 1",
+			uuid:            "C232AB00-9414-11EC-B3C8-9F6BDECED846",
+			expectedUUID:    "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V1,
+			expectedTime:    rfcTime,
+		},
+		{
+			name:            "Version 3",
+			uuid:            "5df41881-3aed-3515-88a7-2f4a814cf09e",
+			expectedUUID:    "5df41881-3aed-3515-88a7-2f4a814cf09e",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V3,
+		},
+		{
+			name:            "Version 4",
+			uuid:            "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			expectedUUID:    "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V4,
+		},
+		{
+			name:            "Version 5",
+			uuid:            "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			expectedUUID:    "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V5,
+		},
+		{
+			name:            "Version 6",
+			uuid:            "1EC9414C-232A-6B00-B3C8-9F6BDECED846",
+			expectedUUID:    "1ec9414c-232a-6b00-b3c8-9f6bdeced846",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V6,
+			expectedTime:    rfcTime,
+		},
+		{
+			name:            "Version 7",
+			uuid:            "017F22E2-79B0-7CC3-98C4-DC0C0C07398F",
+			expectedUUID:    "017f22e2-79b0-7cc3-98c4-dc0c0c07398f",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V7,
+			expectedTime:    rfcTime,
+		},
+		{
+			name:            "Braces without hyphens",
+			uuid:            "{F47AC10B58CC4372A5670E02B2C3D479}",
+			expectedUUID:    "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V4,
+		},
+		{
+			name:            "URN",
+			uuid:            "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			expectedUUID:    "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			expectedVariant: UUID_VARIANT_RFC9562,
+			expectedVersion: UUID_V4,
+		},
+		{
+			name:            "Nil UUID",
+			uuid:            "00000000-0000-0000-0000-000000000000",
+			expectedUUID:    "00000000-0000-0000-0000-000000000000",
+			expectedVariant: UUID_VARIANT_NCS,
+		},
+		{
+			name:            "Max UUID",
+			uuid:            "FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF",
+			expectedUUID:    "ffffffff-ffff-ffff-ffff-ffffffffffff",
+			expectedVariant: UUID_VARIANT_FUTURE,
+		},
+		{
+			name:            "Microsoft GUID",
+			uuid:            "f47ac10b-58cc-4372-c567-0e02b2c3d479",
+			expectedUUID:    "f47ac10b-58cc-4372-c567-0e02b2c3d479",
+			expectedVariant: UUID_VARIANT_MICROSOFT,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuid, err := ParseUUID(tt.uuid)
+			if err != nil {
+				t.Fatalf("ParseUUID() unexpected error: %v", err)
+			}
+			if uuid.UUID != tt.expectedUUID {
+				t.Errorf("ParseUUID() UUID = %v, expected %v", uuid.UUID, tt.expectedUUID)
+			}
+			if uuid.Variant != tt.expectedVariant {
+				t.Errorf("ParseUUID() Variant = %v, expected %v", uuid.Variant, tt.expectedVariant)
+			}
+			if uuid.Version != tt.expectedVersion {
+				t.Errorf("ParseUUID() Version = %v, expected %v", uuid.Version, tt.expectedVersion)
+			}
+			if !uuid.Time.Equal(tt.expectedTime) {
+				t.Errorf("ParseUUID() Time = %v, expected %v", uuid.Time, tt.expectedTime)
+			}
+		})
+	}
+}
+
+// TestValidateUUID tests UUID validation with and without version restrictions
+func TestValidateUUID(t *testing.T) {
+	tests := []struct {
+		name     string
+		uuid     interface{}
+		versions []UUIDVersion
+		expected string
+	}{
+		{name: "Any version", uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{name: "Nil UUID without restriction", uuid: "00000000-0000-0000-0000-000000000000"},
+		{name: "Version 4 restricted to v4", uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d479", versions: []UUIDVersion{UUID_V4}},
+		{name: "Version 7 restricted to v4 or v7", uuid: "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", versions: []UUIDVersion{UUID_V4, UUID_V7}},
+		{
+			name:     "Version 1 restricted to v4",
+			uuid:     "c232ab00-9414-11ec-b3c8-9f6bdeced846",
+			versions: []UUIDVersion{UUID_V4},
+			expected: "UUID version 1 is not allowed",
+		},
+		{
+			name:     "Nil UUID restricted to v4",
+			uuid:     "00000000-0000-0000-0000-000000000000",
+			versions: []UUIDVersion{UUID_V4},
+			expected: "UUID must have the RFC 9562 variant",
+		},
+		{
+			name:     "Version nibble 4 with Microsoft variant",
+			uuid:     "f47ac10b-58cc-4372-c567-0e02b2c3d479",
+			versions: []UUIDVersion{UUID_V4},
+			expected: "UUID must have the RFC 9562 variant",
+		},
+		{name: "Integer input", uuid: 4, expected: "UUID must be a string"},
+		{name: "Empty string", uuid: "", expected: "UUID must have 32 hexadecimal digits"},
+		{name: "Too short", uuid: "f47ac10b-58cc-4372-a567-0e02b2c3d47", expected: "UUID must have 32 hexadecimal digits"},
+		{name: "Misplaced hyphens", uuid: "f47ac10b5-8cc-4372-a567-0e02b2c3d479", expected: "invalid UUID format"},
+		{name: "Extra hyphen", uuid: "f47ac10b-58cc-4372-a567-0e02b2c-d479", expected: "invalid UUID format"},
+		{name: "Non-hex digit", uuid: "g47ac10b-58cc-4372-a567-0e02b2c3d479", expected: "invalid UUID format"},
+		{name: "Unbalanced brace", uuid: "{f47ac10b-58cc-4372-a567-0e02b2c3d479", expected: "UUID must have 32 hexadecimal digits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateUUID(tt.uuid, tt.versions...)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateUUID() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateUUID() error = nil, expected %v", tt.expected)
+			}
+			if err.Error() != tt.expected {
+				t.Errorf("ValidateUUID() error = %v, expected %v", err, tt.expected)
+			}
+		})
+	}
+}
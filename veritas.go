@@ -3,11 +3,19 @@
 package veritas
 
 import (
+	"embed"
 	"fmt"
+	"math/rand/v2"
 	"regexp"
 	"strings"
 )
 
+// tableFiles holds the reference tables embedded in the library, regenerated
+// from their official sources with go generate.
+//
+//go:embed tables/*.json
+var tableFiles embed.FS
+
 // cleanString removes leading/trailing whitespace and converts to lowercase if specified.
 func cleanString(s string, toLower bool) string {
 	cleaned := strings.TrimSpace(s)
@@ -40,3 +48,65 @@ func matchRegex(s, pattern string) (bool, error) {
 	}
 	return regex.MatchString(s), nil
 }
+
+// onlyDigits removes every non-digit character from a string.
+func onlyDigits(s string) string {
+	return regexp.MustCompile(`\D`).ReplaceAllString(s, "")
+}
+
+// isRepeatedDigits checks if a string is made of a single repeated character.
+func isRepeatedDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	return strings.Count(s, s[:1]) == len(s)
+}
+
+// weightedSum multiplies each digit by the weight in the same position and
+// returns the sum of the products.
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i, digit := range digits {
+		sum += int(digit-'0') * weights[i]
+	}
+	return sum
+}
+
+// randomDigits returns a string of n pseudo-random digits.
+func randomDigits(n int) string {
+	digits := make([]byte, n)
+	for i := range digits {
+		digits[i] = byte('0' + rand.IntN(10))
+	}
+	return string(digits)
+}
+
+// accentReplacer maps accented Latin letters used in Portuguese to their base letter.
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// foldName lowercases a name, removes accents and collapses whitespace so
+// that names can be compared regardless of how they were typed.
+func foldName(s string) string {
+	folded := accentReplacer.Replace(strings.ToLower(s))
+	return strings.Join(strings.Fields(folded), " ")
+}
+
+// mustLoadEmbeddedTable decodes a table embedded in the library with decode.
+func mustLoadEmbeddedTable[T any](name string, decode func(data []byte) (T, error)) T {
+	data, err := tableFiles.ReadFile(name)
+	if err != nil {
+		panic(err)
+	}
+	table, err := decode(data)
+	if err != nil {
+		panic(fmt.Errorf("%s: %w", name, err))
+	}
+	return table
+}
@@ -630,3 +630,133 @@ func TestUtilityFunctions_EdgeCases(t *testing.T) {
 		}
 	})
 }
+
+// TestOnlyDigits tests the onlyDigits utility function
+func TestOnlyDigits(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Formatted document",
+			input:    "111.444.777-35",
+			expected: "11144477735",
+		},
+		{
+			name:     "Letters and spaces",
+			input:    "ab 12 cd 3",
+			expected: "123",
+		},
+		{
+			name:     "No digits",
+			input:    "abc",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := onlyDigits(tt.input)
+			if result != tt.expected {
+				t.Errorf("onlyDigits() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestIsRepeatedDigits tests the isRepeatedDigits utility function
+func TestIsRepeatedDigits(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "All same digits",
+			input:    "1111111",
+			expected: true,
+		},
+		{
+			name:     "Single digit",
+			input:    "7",
+			expected: true,
+		},
+		{
+			name:     "Mixed digits",
+			input:    "1111112",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := isRepeatedDigits(tt.input)
+			if result != tt.expected {
+				t.Errorf("isRepeatedDigits() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestWeightedSum tests the weightedSum utility function
+func TestWeightedSum(t *testing.T) {
+	result := weightedSum("1234", []int{5, 4, 3, 2})
+	if result != 30 {
+		t.Errorf("weightedSum() = %v, expected %v", result, 30)
+	}
+}
+
+// TestFoldName tests the foldName utility function
+func TestFoldName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "Accents and uppercase",
+			input:    "São Paulo",
+			expected: "sao paulo",
+		},
+		{
+			name:     "Cedilla and extra spaces",
+			input:    "  Foz do  Iguaçu ",
+			expected: "foz do iguacu",
+		},
+		{
+			name:     "Uppercase accented letters",
+			input:    "GOIÂNIA",
+			expected: "goiania",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := foldName(tt.input)
+			if result != tt.expected {
+				t.Errorf("foldName() = %v, expected %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+// TestMustLoadEmbeddedTable tests decoding of embedded tables
+func TestMustLoadEmbeddedTable(t *testing.T) {
+	directory := mustLoadEmbeddedTable("tables/banks.json", decodeBankDirectory)
+	if len(directory.byCode) == 0 {
+		t.Errorf("mustLoadEmbeddedTable() returned an empty bank directory")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("mustLoadEmbeddedTable() expected panic for missing table, got nil")
+		}
+	}()
+	mustLoadEmbeddedTable("tables/missing.json", decodeBankDirectory)
+}
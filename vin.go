@@ -0,0 +1,110 @@
+// Package veritas provides vehicle identification number (VIN) validation functions.
+package veritas
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// vinFormat matches the ISO 3779 character set, which excludes I, O and Q.
+var vinFormat = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+// vinValues maps each VIN character to its value in the check digit calculation.
+var vinValues = map[rune]int{
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+// vinWeights are the position weights of the VIN check digit calculation.
+var vinWeights = []int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// vinYearCodes lists the model year codes in order, starting at 1980.
+// The codes repeat every 30 years.
+const vinYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+// VIN is a parsed vehicle identification number.
+type VIN struct {
+	Number     string
+	WMI        string
+	VDS        string
+	VIS        string
+	CheckDigit string
+	// ModelYears holds the candidate model years of the 10th character,
+	// which repeat every 30 years, in ascending order.
+	ModelYears []int
+}
+
+// ValidateVIN validates a 17-character vehicle identification number.
+func ValidateVIN(vin interface{}) error {
+	_, err := ParseVIN(vin)
+	return err
+}
+
+// ValidateNorthAmericanVIN validates a VIN and its position-9 check digit,
+// which is mandatory for vehicles built for the North American market.
+func ValidateNorthAmericanVIN(vin interface{}) error {
+	parsed, err := ParseVIN(vin)
+	if err != nil {
+		return err
+	}
+
+	if parsed.CheckDigit != vinCheckDigit(parsed.Number) {
+		return fmt.Errorf("invalid VIN check digit")
+	}
+	return nil
+}
+
+// ParseVIN validates a VIN and returns its sections and candidate model years.
+func ParseVIN(vin interface{}) (VIN, error) {
+	vinStr, ok := vin.(string)
+	if !ok {
+		return VIN{}, fmt.Errorf("VIN must be a string")
+	}
+
+	// Clean the VIN string (remove spaces and hyphens)
+	vinStr = strings.ToUpper(regexp.MustCompile(`[\s\-]`).ReplaceAllString(vinStr, ""))
+
+	if len(vinStr) != 17 {
+		return VIN{}, fmt.Errorf("VIN must have exactly 17 characters")
+	}
+	if !vinFormat.MatchString(vinStr) {
+		return VIN{}, fmt.Errorf("VIN contains invalid characters")
+	}
+
+	return VIN{
+		Number:     vinStr,
+		WMI:        vinStr[:3],
+		VDS:        vinStr[3:9],
+		VIS:        vinStr[9:],
+		CheckDigit: vinStr[8:9],
+		ModelYears: vinModelYears(vinStr[9]),
+	}, nil
+}
+
+// vinCheckDigit calculates the position-9 check digit of a VIN.
+func vinCheckDigit(vin string) string {
+	sum := 0
+	for i, c := range vin {
+		value, ok := vinValues[c]
+		if !ok {
+			value = int(c - '0')
+		}
+		sum += value * vinWeights[i]
+	}
+
+	if sum%11 == 10 {
+		return "X"
+	}
+	return fmt.Sprint(sum % 11)
+}
+
+// vinModelYears returns the candidate model years for a year code.
+func vinModelYears(code byte) []int {
+	index := strings.IndexByte(vinYearCodes, code)
+	if index < 0 {
+		return nil
+	}
+	return []int{1980 + index, 2010 + index}
+}
@@ -0,0 +1,157 @@
+// Package veritas provides comprehensive unit tests for VIN validation functions.
+package veritas
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseVIN_ValidCases tests parsing of valid VINs
+func TestParseVIN_ValidCases(t *testing.T) {
+	tests := []struct {
+		name          string
+		vin           string
+		expectedWMI   string
+		expectedYears []int
+	}{
+		{
+			name:          "North American VIN",
+			vin:           "1M8GDM9AXKP042788",
+			expectedWMI:   "1M8",
+			expectedYears: []int{1989, 2019},
+		},
+		{
+			name:          "Lowercase VIN with numeric year code",
+			vin:           "1hgcm82633a004352",
+			expectedWMI:   "1HG",
+			expectedYears: []int{2003, 2033},
+		},
+		{
+			name:          "Brazilian VIN with spaces",
+			vin:           "9BW ZZZ377 VT004251",
+			expectedWMI:   "9BW",
+			expectedYears: []int{1997, 2027},
+		},
+		{
+			name:          "VIN with invalid year code",
+			vin:           "9BWZZZ377UT004251",
+			expectedWMI:   "9BW",
+			expectedYears: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vin, err := ParseVIN(tt.vin)
+			if err != nil {
+				t.Fatalf("ParseVIN() unexpected error: %v", err)
+			}
+			if vin.WMI != tt.expectedWMI {
+				t.Errorf("ParseVIN() WMI = %v, expected %v", vin.WMI, tt.expectedWMI)
+			}
+			if !reflect.DeepEqual(vin.ModelYears, tt.expectedYears) {
+				t.Errorf("ParseVIN() ModelYears = %v, expected %v", vin.ModelYears, tt.expectedYears)
+			}
+		})
+	}
+}
+
+// TestValidateVIN_InvalidCases tests invalid VINs
+func TestValidateVIN_InvalidCases(t *testing.T) {
+	tests := []struct {
+		name     string
+		vin      interface{}
+		expected string
+	}{
+		{
+			name:     "Integer input",
+			vin:      12345,
+			expected: "VIN must be a string",
+		},
+		{
+			name:     "Too short",
+			vin:      "1M8GDM9AXKP04278",
+			expected: "VIN must have exactly 17 characters",
+		},
+		{
+			name:     "Contains letter O",
+			vin:      "1M8GDM9AXKP0427O8",
+			expected: "VIN contains invalid characters",
+		},
+		{
+			name:     "Contains letter I",
+			vin:      "1M8GDM9AXKPI42788",
+			expected: "VIN contains invalid characters",
+		},
+		{
+			name:     "Contains symbol",
+			vin:      "1M8GDM9AXKP04278*",
+			expected: "VIN contains invalid characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVIN(tt.vin)
+			if err == nil {
+				t.Errorf("ValidateVIN() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateVIN() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+}
+
+// TestValidateNorthAmericanVIN tests the position-9 check digit
+func TestValidateNorthAmericanVIN(t *testing.T) {
+	tests := []struct {
+		name     string
+		vin      string
+		expected string
+	}{
+		{
+			name:     "Check digit X",
+			vin:      "1M8GDM9AXKP042788",
+			expected: "",
+		},
+		{
+			name:     "Numeric check digit",
+			vin:      "1HGCM82633A004352",
+			expected: "",
+		},
+		{
+			name:     "All ones",
+			vin:      "11111111111111111",
+			expected: "",
+		},
+		{
+			name:     "Wrong check digit",
+			vin:      "1HGCM82643A004352",
+			expected: "invalid VIN check digit",
+		},
+		{
+			name:     "VIN without North American check digit",
+			vin:      "9BWZZZ377VT004251",
+			expected: "invalid VIN check digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNorthAmericanVIN(tt.vin)
+			if tt.expected == "" {
+				if err != nil {
+					t.Errorf("ValidateNorthAmericanVIN() error = %v, expected nil", err)
+				}
+			} else if err == nil {
+				t.Errorf("ValidateNorthAmericanVIN() expected error, got nil")
+			} else if err.Error() != tt.expected {
+				t.Errorf("ValidateNorthAmericanVIN() error = %v, expected %v", err.Error(), tt.expected)
+			}
+		})
+	}
+
+	if err := ValidateVIN("9BWZZZ377VT004251"); err != nil {
+		t.Errorf("ValidateVIN() error = %v, expected nil", err)
+	}
+}